@@ -0,0 +1,16 @@
+data "units_catalog" "data_size" {
+  category = "data_size"
+}
+
+variable "disk_size_unit" {
+  type = string
+}
+
+output "disk_size_unit" {
+  value = var.disk_size_unit
+
+  precondition {
+    condition     = contains(data.units_catalog.data_size.categories[0].units, var.disk_size_unit)
+    error_message = "Unit must be one of: ${join(", ", data.units_catalog.data_size.categories[0].units)}."
+  }
+}
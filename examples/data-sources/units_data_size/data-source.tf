@@ -13,3 +13,22 @@ data "units_data_size" "fs_block_size" {
 output "full_fs_block_size" {
   value = data.units_data_size.fs_block_size.kibibytes
 }
+
+data "units_data_size" "memory" {
+  value = "20GiB"
+}
+
+output "memory_bytes" {
+  value = data.units_data_size.memory.bytes
+}
+
+data "units_data_size" "volume_size" {
+  gibibytes             = 1.5
+  decimal_places        = 0
+  rounding_mode         = "ceiling"
+  require_integer_units = ["GB"]
+}
+
+output "volume_size_gb" {
+  value = data.units_data_size.volume_size.gigabytes
+}
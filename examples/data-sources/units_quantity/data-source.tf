@@ -0,0 +1,16 @@
+data "units_quantity" "memory" {
+  quantity = "1.5GiB"
+}
+
+output "memory_mb" {
+  value = data.units_quantity.memory.values["megabytes"]
+}
+
+data "units_quantity" "timeout" {
+  value = 90
+  unit  = "min"
+}
+
+output "timeout_seconds" {
+  value = data.units_quantity.timeout.base_value
+}
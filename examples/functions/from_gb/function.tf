@@ -1,3 +1,4 @@
+# 4 gigabytes = 4000000000 bytes
 output "example" {
-  size_in_bytes = provider::units::from_gb(42)
+  size_in_bytes = provider::units::from_gb(4)
 }
\ No newline at end of file
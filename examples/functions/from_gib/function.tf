@@ -1,3 +1,4 @@
+# 4 gibibytes = 4294967296 bytes
 output "example" {
-  size_in_bytes = provider::units::from_gib(42)
+  size_in_bytes = provider::units::from_gib(4)
 }
\ No newline at end of file
@@ -0,0 +1,4 @@
+# 4 gibibytes = 4294967296 bytes
+output "example" {
+  size_in_bytes = provider::units::from_gibibytes(4)
+}
\ No newline at end of file
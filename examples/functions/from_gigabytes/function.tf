@@ -0,0 +1,4 @@
+# 4 gigabytes = 4000000000 bytes
+output "example" {
+  size_in_bytes = provider::units::from_gigabytes(4)
+}
\ No newline at end of file
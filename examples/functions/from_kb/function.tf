@@ -1,3 +1,4 @@
+# 4 kilobytes = 4000 bytes
 output "example" {
-  size_in_bytes = provider::units::from_kb(42)
+  size_in_bytes = provider::units::from_kb(4)
 }
\ No newline at end of file
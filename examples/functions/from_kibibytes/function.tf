@@ -0,0 +1,4 @@
+# 4 kibibytes = 4096 bytes
+output "example" {
+  size_in_bytes = provider::units::from_kibibytes(4)
+}
\ No newline at end of file
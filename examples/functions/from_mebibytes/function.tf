@@ -0,0 +1,4 @@
+# 4 mebibytes = 4194304 bytes
+output "example" {
+  size_in_bytes = provider::units::from_mebibytes(4)
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+# 4 megabytes = 4000000 bytes
+output "example" {
+  size_in_bytes = provider::units::from_megabytes(4)
+}
\ No newline at end of file
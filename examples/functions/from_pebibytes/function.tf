@@ -0,0 +1,4 @@
+# 4 pebibytes = 4503599627370496 bytes
+output "example" {
+  size_in_bytes = provider::units::from_pebibytes(4)
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+# 4 petabytes = 4000000000000000 bytes
+output "example" {
+  size_in_bytes = provider::units::from_petabytes(4)
+}
\ No newline at end of file
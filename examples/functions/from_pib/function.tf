@@ -1,3 +1,4 @@
+# 4 pebibytes = 4503599627370496 bytes
 output "example" {
-  size_in_bytes = provider::units::from_pib(42)
+  size_in_bytes = provider::units::from_pib(4)
 }
\ No newline at end of file
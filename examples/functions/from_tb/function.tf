@@ -1,3 +1,4 @@
+# 4 terabytes = 4000000000000 bytes
 output "example" {
-  size_in_bytes = provider::units::from_tb(42)
+  size_in_bytes = provider::units::from_tb(4)
 }
\ No newline at end of file
@@ -0,0 +1,4 @@
+# 4 tebibytes = 4398046511104 bytes
+output "example" {
+  size_in_bytes = provider::units::from_tebibytes(4)
+}
\ No newline at end of file
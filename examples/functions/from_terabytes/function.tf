@@ -0,0 +1,4 @@
+# 4 terabytes = 4000000000000 bytes
+output "example" {
+  size_in_bytes = provider::units::from_terabytes(4)
+}
\ No newline at end of file
@@ -1,3 +1,4 @@
+# 4 tebibytes = 4398046511104 bytes
 output "example" {
-  size_in_bytes = provider::units::from_tib(42)
+  size_in_bytes = provider::units::from_tib(4)
 }
\ No newline at end of file
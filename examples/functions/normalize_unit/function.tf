@@ -0,0 +1,4 @@
+# "kB/s"
+output "example" {
+  unit = provider::units::normalize_unit("kilobytes / second")
+}
\ No newline at end of file
@@ -1,3 +1,4 @@
+# 4000000000 bytes = 4 gigabytes
 output "example" {
-  size_in_gigabytes = provider::units::to_gb(42)
+  size_in_gigabytes = provider::units::to_gb(4000000000)
 }
\ No newline at end of file
@@ -0,0 +1,4 @@
+# 4294967296 bytes = 4 gibibytes
+output "example" {
+  size_in_gibibytes = provider::units::to_gibibytes(4294967296)
+}
\ No newline at end of file
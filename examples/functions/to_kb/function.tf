@@ -1,3 +1,4 @@
+# 4000 bytes = 4 kilobytes
 output "example" {
-  size_in_kilobytes = provider::units::to_kb(42)
+  size_in_kilobytes = provider::units::to_kb(4000)
 }
\ No newline at end of file
@@ -1,3 +1,4 @@
+# 4096 bytes = 4 kibibytes
 output "example" {
-  size_in_kibibytes = provider::units::to_kib(42)
+  size_in_kibibytes = provider::units::to_kib(4096)
 }
\ No newline at end of file
@@ -0,0 +1,4 @@
+# 4096 bytes = 4 kibibytes
+output "example" {
+  size_in_kibibytes = provider::units::to_kibibytes(4096)
+}
\ No newline at end of file
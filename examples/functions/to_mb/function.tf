@@ -1,3 +1,4 @@
+# 4000000 bytes = 4 megabytes
 output "example" {
-  size_in_megabytes = provider::units::to_mb(42)
+  size_in_megabytes = provider::units::to_mb(4000000)
 }
\ No newline at end of file
@@ -0,0 +1,4 @@
+# 4194304 bytes = 4 mebibytes
+output "example" {
+  size_in_mebibytes = provider::units::to_mebibytes(4194304)
+}
\ No newline at end of file
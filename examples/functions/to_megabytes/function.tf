@@ -0,0 +1,4 @@
+# 4000000 bytes = 4 megabytes
+output "example" {
+  size_in_megabytes = provider::units::to_megabytes(4000000)
+}
\ No newline at end of file
@@ -1,3 +1,4 @@
+# 4194304 bytes = 4 mebibytes
 output "example" {
-  size_in_mebibytes = provider::units::to_mib(42)
+  size_in_mebibytes = provider::units::to_mib(4194304)
 }
\ No newline at end of file
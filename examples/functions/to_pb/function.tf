@@ -1,3 +1,4 @@
+# 4000000000000000 bytes = 4 petabytes
 output "example" {
-  size_in_petabytes = provider::units::to_pb(42)
+  size_in_petabytes = provider::units::to_pb(4000000000000000)
 }
\ No newline at end of file
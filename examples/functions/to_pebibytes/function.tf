@@ -0,0 +1,4 @@
+# 4503599627370496 bytes = 4 pebibytes
+output "example" {
+  size_in_pebibytes = provider::units::to_pebibytes(4503599627370496)
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+# 4000000000000000 bytes = 4 petabytes
+output "example" {
+  size_in_petabytes = provider::units::to_petabytes(4000000000000000)
+}
\ No newline at end of file
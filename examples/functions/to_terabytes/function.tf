@@ -0,0 +1,4 @@
+# 4000000000000 bytes = 4 terabytes
+output "example" {
+  size_in_terabytes = provider::units::to_terabytes(4000000000000)
+}
\ No newline at end of file
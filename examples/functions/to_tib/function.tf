@@ -1,3 +1,4 @@
+# 4398046511104 bytes = 4 tebibytes
 output "example" {
-  size_in_tebibytes = provider::units::to_tib(42)
+  size_in_tebibytes = provider::units::to_tib(4398046511104)
 }
\ No newline at end of file
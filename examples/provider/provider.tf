@@ -1 +1,21 @@
 provider "units" {}
+
+provider "units" {
+  alias = "custom"
+
+  custom_unit {
+    name     = "slots"
+    symbol   = "slot"
+    category = "data_size"
+    factor   = 8
+    unit     = "GiB"
+  }
+}
+
+provider "units" {
+  alias = "rounded"
+
+  decimal_places = 2
+  rounding_mode  = "half_up"
+  unit_system    = "si"
+}
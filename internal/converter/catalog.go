@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package converter
+
+import (
+	"strings"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+// Category describes units of one quantity, which are converted to each other through the base unit.
+type Category struct {
+	Name     string
+	Quantity string
+	Base     string
+	// NonNegative is set for quantities which can't be negative, e.g. data size.
+	NonNegative bool
+	Units       []Unit
+}
+
+// Unit describes a unit of a Category along with its conversions relative to the base unit.
+type Unit struct {
+	Name     string
+	Short    string
+	Aliases  []Alias
+	FromBase unitConverter
+	ToBase   unitConverter
+}
+
+// Alias is an alternative short name of a Unit.
+type Alias struct {
+	Name       string
+	Deprecated bool
+}
+
+// UnitNames returns names of all units of a category registered in the default registry, including the base unit.
+func UnitNames(category string) []string {
+	c, ok := units.Default.Category(category)
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	for _, unit := range c.Units {
+		names = append(names, unit.Name)
+	}
+
+	return names
+}
+
+// CategoryOf returns the category of the Catalog named after category,
+// or a category described by its name otherwise, e.g. "data size bits" for data_size_bits.
+func CategoryOf(category units.Category) Category {
+	for _, c := range Catalog {
+		if c.Name == category.Name {
+			return c
+		}
+	}
+
+	return Category{
+		Name:     category.Name,
+		Quantity: strings.ReplaceAll(category.Name, "_", " "),
+		Base:     category.Base.Name,
+	}
+}
@@ -5,6 +5,39 @@
 
 package converter
 
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
 type Converter interface {
-	Convert()
+	// Convert converts the given value to all other units.
+	Convert(options Options) diag.Diagnostics
+}
+
+// Options configure conversions of a Converter.
+type Options struct {
+	// Precision is the precision of results in bits.
+	// Zero keeps the precision of the given value, but at least units.DefaultPrecision bits.
+	Precision uint
+	// Registry holds units to convert, including custom ones. Nil means units.Default.
+	Registry *units.Registry
+	// Rounding rounds results to decimal places. Nil keeps results as is.
+	Rounding *units.Rounding
+	// UnitSystem is the unit system automatically formatted quantities are written in.
+	UnitSystem units.UnitSystem
+	// Formatting rounds formatted quantities, e.g. "1.5 GiB".
+	Formatting units.Rounding
+	// Strict turns results rounded to Precision into errors.
+	Strict bool
+}
+
+// UnitRegistry returns the registry of units of o.
+func (o Options) UnitRegistry() *units.Registry {
+	if o.Registry == nil {
+		return units.Default
+	}
+
+	return o.Registry
 }
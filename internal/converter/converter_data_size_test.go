@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package converter_test
+
+import (
+	"testing"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+)
+
+func TestDataSizeCategory(t *testing.T) {
+	units := map[string]converter.Unit{}
+	for _, unit := range converter.DataSizeCategory.Units {
+		units[unit.Name] = unit
+	}
+
+	names := converter.UnitNames(converter.DataSizeCategory.Name)
+	for _, name := range names {
+		if name == converter.DataSizeCategory.Base {
+			continue
+		}
+
+		unit, ok := units[name]
+		if !ok {
+			t.Errorf("unit %q is missing in the catalog", name)
+			continue
+		}
+		if unit.FromBase == nil {
+			t.Errorf("unit %q has no conversion from bytes", name)
+		}
+		if unit.ToBase == nil {
+			t.Errorf("unit %q has no conversion to bytes", name)
+		}
+	}
+
+	if len(units) != len(names)-1 {
+		t.Errorf("expected %d units, got %d", len(names)-1, len(units))
+	}
+}
+
+func TestDataSizeCategory_DeprecatedAliases(t *testing.T) {
+	for _, unit := range converter.DataSizeCategory.Units {
+		for _, alias := range unit.Aliases {
+			if alias.Name == unit.Name && !alias.Deprecated {
+				t.Errorf("alias %q of %s is not deprecated in favor of %q", alias.Name, unit.Name, unit.Short)
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package converter
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+// Convert converts number from one unit of c to another by their names and rounds the result to precision bits.
+// Zero precision keeps the precision of number, but at least units.DefaultPrecision bits.
+//
+// If the result, written in decimal, differs from the exact value, it is returned along with ErrPrecisionLoss.
+func (c Category) Convert(number types.Number, from, to string, precision uint) (types.Number, error) {
+	conversion, ok := matrices[c.Name][unitPair{from: from, to: to}]
+	if !ok {
+		for _, name := range []string{from, to} {
+			if !slices.Contains(UnitNames(c.Name), name) {
+				return types.NumberUnknown(), fmt.Errorf("%w %q of %s", ErrUnknownUnit, name, c.Quantity)
+			}
+		}
+
+		return types.NumberUnknown(), fmt.Errorf("%w: cannot convert %s to %s", units.ErrIncompatibleUnits, from, to)
+	}
+
+	return conversion.convert(number, precision)
+}
+
+// ConvertUnits converts number from one unit of c to another like Convert.
+// Units missing in the default registry, e.g. custom units of the provider configuration,
+// and units of other categories of the dimension of c, e.g. bits or compound units, are converted directly.
+func (c Category) ConvertUnits(number types.Number, from, to units.Unit, precision uint) (types.Number, error) {
+	if conversion, ok := matrices[c.Name][unitPair{from: from.Name, to: to.Name}]; ok {
+		return conversion.convert(number, precision)
+	}
+
+	// The base unit of expressions without a category is an expression as well, e.g. B/s.
+	base, err := units.Default.Parse(c.Base)
+	if err != nil {
+		return types.NumberUnknown(), err
+	}
+	for _, unit := range []units.Unit{from, to} {
+		if unit.Category != c.Name && !units.Compatible(unit, base) {
+			return types.NumberUnknown(), fmt.Errorf("%w: %s is not a unit of %s", units.ErrIncompatibleUnits, unit.Name, c.Quantity)
+		}
+	}
+
+	return newConversion(from, to, c.checks()...).convert(number, precision)
+}
+
+// checks returns checks of values of c before conversion.
+func (c Category) checks() []check {
+	if c.NonNegative {
+		return []check{nonNegative}
+	}
+
+	return nil
+}
+
+type unitPair struct {
+	from string
+	to   string
+}
+
+// matrices are conversions between every pair of units of every category in the Catalog by category names.
+var matrices = map[string]map[unitPair]conversion{}
+
+func init() {
+	for _, category := range Catalog {
+		matrices[category.Name] = newMatrix(category)
+	}
+}
+
+func newMatrix(category Category) map[unitPair]conversion {
+	checks := category.checks()
+
+	c, ok := units.Default.Category(category.Name)
+	if !ok {
+		return nil
+	}
+
+	matrix := map[unitPair]conversion{}
+	for _, from := range c.Units {
+		for _, to := range c.Units {
+			matrix[unitPair{from: from.Name, to: to.Name}] = newConversion(from, to, checks...)
+		}
+	}
+
+	return matrix
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package converter_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+func TestCategoryConvert_Allocations(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		number   types.Number
+		from, to string
+	}{{
+		name:   "same unit",
+		number: types.NumberValue(big.NewFloat(1.5)),
+		from:   "gibibytes",
+		to:     "gibibytes",
+	}, {
+		name:   "zero",
+		number: types.NumberValue(big.NewFloat(0)),
+		from:   "gibibytes",
+		to:     "megabytes",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				if _, err := converter.DataSizeCategory.Convert(tc.number, tc.from, tc.to, 0); err != nil {
+					t.Fatal(err)
+				}
+			})
+			if allocs != 0 {
+				t.Errorf("expected no allocations, got %v", allocs)
+			}
+		})
+	}
+}
+
+func TestCategoryConvert_Matrix(t *testing.T) {
+	number := types.NumberValue(big.NewFloat(3))
+	names := converter.UnitNames(converter.DataSizeCategory.Name)
+
+	for _, from := range names {
+		for _, to := range names {
+			direct, err := converter.DataSizeCategory.Convert(number, from, to, 512)
+			if err != nil {
+				t.Fatalf("%s to %s: %v", from, to, err)
+			}
+
+			bytes, err := converter.DataSizeCategory.Convert(number, from, converter.DataSizeCategory.Base, 512)
+			if err != nil {
+				t.Fatalf("%s to bytes: %v", from, err)
+			}
+			throughBytes, err := converter.DataSizeCategory.Convert(bytes, converter.DataSizeCategory.Base, to, 512)
+			if err != nil {
+				t.Fatalf("bytes to %s: %v", to, err)
+			}
+
+			if direct.ValueBigFloat().Cmp(throughBytes.ValueBigFloat()) != 0 {
+				t.Errorf("%s to %s: expected %s, got %s", from, to, throughBytes, direct)
+			}
+		}
+	}
+}
+
+func TestCategoryConvertUnits_Custom(t *testing.T) {
+	slots, err := units.DeriveUnit("slots", "slot", big.NewRat(8, 1), units.Gibibytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := converter.DataSizeCategory.ConvertUnits(types.NumberValue(big.NewFloat(2)), slots, units.Mebibytes, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ValueBigFloat().Cmp(big.NewFloat(16384)) != 0 {
+		t.Errorf("expected 16384, got %s", res)
+	}
+
+	if _, err = converter.DataSizeCategory.ConvertUnits(types.NumberValue(big.NewFloat(-1)), slots, units.Bytes, 0); !errors.Is(err, converter.ErrNegative) {
+		t.Errorf("expected %v, got %v", converter.ErrNegative, err)
+	}
+	if _, err = converter.DataSizeCategory.ConvertUnits(types.NumberValue(big.NewFloat(1)), slots, units.Seconds, 0); !errors.Is(err, units.ErrIncompatibleUnits) {
+		t.Errorf("expected %v, got %v", units.ErrIncompatibleUnits, err)
+	}
+}
+
+func TestCategoryConvertUnits_SameDimension(t *testing.T) {
+	compound, err := units.Default.Parse("GiB*s/s")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := converter.DataSizeCategory.ConvertUnits(types.NumberValue(big.NewFloat(1.5)), compound, units.Mebibytes, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ValueBigFloat().Cmp(big.NewFloat(1536)) != 0 {
+		t.Errorf("expected 1536, got %s", res)
+	}
+
+	res, err = converter.DataSizeCategory.ConvertUnits(types.NumberValue(big.NewFloat(8)), units.Gibibits, units.Mebibytes, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ValueBigFloat().Cmp(big.NewFloat(1024)) != 0 {
+		t.Errorf("expected 1024, got %s", res)
+	}
+
+	velocity, err := units.Default.Parse("m/s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = converter.DataSizeCategory.ConvertUnits(types.NumberValue(big.NewFloat(1)), velocity, units.Bytes, 0); !errors.Is(err, units.ErrIncompatibleUnits) {
+		t.Errorf("expected %v, got %v", units.ErrIncompatibleUnits, err)
+	}
+}
+
+func TestCategoryOf(t *testing.T) {
+	if category := converter.CategoryOf(units.DataSize); !category.NonNegative {
+		t.Errorf("expected the data size category of the catalog, got %+v", category)
+	}
+
+	category := converter.CategoryOf(units.DataSizeBits)
+	if category.Quantity != "data size bits" || category.Base != units.DataSizeBits.Base.Name {
+		t.Errorf("unexpected category %+v", category)
+	}
+}
+
+func BenchmarkCategoryConvert(b *testing.B) {
+	for _, bc := range []struct {
+		name      string
+		value     float64
+		from, to  string
+		precision uint
+	}{
+		{name: "same unit", value: 1.5, from: "gibibytes", to: "gibibytes"},
+		{name: "zero", value: 0, from: "gibibytes", to: "megabytes"},
+		{name: "binary to binary", value: 1.5, from: "gibibytes", to: "mebibytes"},
+		{name: "binary to decimal", value: 1.5, from: "gibibytes", to: "megabytes"},
+		{name: "decimal to decimal", value: 1.5, from: "gigabytes", to: "megabytes"},
+		{name: "decimal to binary with precision", value: 1000, from: "gigabytes", to: "gibibytes", precision: 512},
+	} {
+		number := types.NumberValue(big.NewFloat(bc.value))
+
+		b.Run(bc.name+"/matrix", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = converter.DataSizeCategory.Convert(number, bc.from, bc.to, bc.precision)
+			}
+		})
+
+		from, _ := units.Default.Lookup(bc.from)
+		to, _ := units.Default.Lookup(bc.to)
+		b.Run(bc.name+"/closure", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = closureConvert(number, from, to, bc.precision)
+			}
+		})
+	}
+}
+
+// closureConvert is the conversion, which unit converters made before the matrix of conversions was precomputed,
+// kept to compare the matrix with.
+func closureConvert(number types.Number, from, to units.Unit, precision uint) (types.Number, error) {
+	value := number.ValueBigFloat()
+	if precision == 0 {
+		precision = max(value.Prec(), units.DefaultPrecision)
+	}
+
+	r, _ := value.Rat(nil)
+	exact, err := units.Convert(r, from, to)
+	if err != nil {
+		return types.NumberUnknown(), err
+	}
+
+	res := new(big.Float).SetPrec(precision).SetRat(exact)
+	if d, ok := new(big.Rat).SetString(res.Text('g', -1)); !ok || d.Cmp(exact) != 0 {
+		return types.NumberValue(res), converter.ErrPrecisionLoss
+	}
+
+	return types.NumberValue(res), nil
+}
+
+func BenchmarkUnitConverter(b *testing.B) {
+	number := types.NumberValue(big.NewFloat(1.5))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = converter.GibibytesToBytes(number, 0)
+	}
+}
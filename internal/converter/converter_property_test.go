@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package converter_test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+// referenceBytes are sizes of data size units in bytes, written independently of the unit catalog.
+var referenceBytes = map[string]*big.Rat{
+	"bytes":     pow(1024, 0),
+	"kibibytes": pow(1024, 1),
+	"mebibytes": pow(1024, 2),
+	"gibibytes": pow(1024, 3),
+	"tebibytes": pow(1024, 4),
+	"pebibytes": pow(1024, 5),
+	"kilobytes": pow(1000, 1),
+	"megabytes": pow(1000, 2),
+	"gigabytes": pow(1000, 3),
+	"terabytes": pow(1000, 4),
+	"petabytes": pow(1000, 5),
+}
+
+func pow(base, exponent int64) *big.Rat {
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(base), big.NewInt(exponent), nil))
+}
+
+// roundTripPrecision is the precision of intermediate results, which is enough to restore any float64 exactly.
+const roundTripPrecision = 256
+
+func dataSizeUnit(i uint8) string {
+	names := converter.UnitNames(converter.DataSizeCategory.Name)
+	return names[int(i)%len(names)]
+}
+
+func FuzzDataSizeConvert(f *testing.F) {
+	f.Add(1.0, uint8(0), uint8(3))
+	f.Add(0.1, uint8(6), uint8(3))
+	f.Add(1e15, uint8(0), uint8(5))
+	f.Add(4.5e-300, uint8(10), uint8(1))
+	f.Add(1.7976931348623157e308, uint8(5), uint8(0))
+	f.Add(-1.0, uint8(1), uint8(2))
+
+	f.Fuzz(func(t *testing.T, value float64, fromIndex, toIndex uint8) {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			t.Skip()
+		}
+		from, to := dataSizeUnit(fromIndex), dataSizeUnit(toIndex)
+		number := types.NumberValue(big.NewFloat(value))
+
+		res, err := converter.DataSizeCategory.Convert(number, from, to, 0)
+		if value < 0 {
+			if !errors.Is(err, converter.ErrNegative) {
+				t.Fatalf("expected %v, got %v", converter.ErrNegative, err)
+			}
+			return
+		}
+		if err != nil && !errors.Is(err, converter.ErrPrecisionLoss) {
+			t.Fatal(err)
+		}
+
+		checkReference(t, number, from, to, res)
+		checkRoundTrip(t, number, from, to)
+	})
+}
+
+// checkReference checks that res is the exact conversion of number, written as the shortest decimal representing it,
+// rounded to the precision of res.
+func checkReference(t *testing.T, number types.Number, from, to string, res types.Number) {
+	t.Helper()
+
+	value, _ := units.Decimal(number.ValueBigFloat())
+	exact := value.Mul(value, referenceBytes[from])
+	exact.Quo(exact, referenceBytes[to])
+
+	expected := new(big.Float).SetPrec(res.ValueBigFloat().Prec()).SetRat(exact)
+	if res.ValueBigFloat().Cmp(expected) != 0 {
+		t.Errorf("%s %s in %s: expected %s, got %s", number, from, to, expected.Text('g', -1), res.ValueBigFloat().Text('g', -1))
+	}
+}
+
+// checkRoundTrip checks that converting number to another unit and back restores it exactly.
+func checkRoundTrip(t *testing.T, number types.Number, from, to string) {
+	t.Helper()
+
+	there, err := converter.DataSizeCategory.Convert(number, from, to, roundTripPrecision)
+	if err != nil && !errors.Is(err, converter.ErrPrecisionLoss) {
+		t.Fatal(err)
+	}
+	back, err := converter.DataSizeCategory.Convert(there, to, from, number.ValueBigFloat().Prec())
+	if err != nil && !errors.Is(err, converter.ErrPrecisionLoss) {
+		t.Fatal(err)
+	}
+
+	if back.ValueBigFloat().Cmp(number.ValueBigFloat()) != 0 {
+		t.Errorf("%s %s to %s and back: got %s", number, from, to, back.ValueBigFloat().Text('g', -1))
+	}
+}
+
+func TestDataSizeConvert_Properties(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 1000; i++ {
+		// Values span many orders of magnitude, including fractions and values beyond int64.
+		value := math.Ldexp(r.Float64(), r.Intn(200)-100)
+		from, to := dataSizeUnit(uint8(r.Intn(256))), dataSizeUnit(uint8(r.Intn(256)))
+		number := types.NumberValue(big.NewFloat(value))
+
+		res, err := converter.DataSizeCategory.Convert(number, from, to, 0)
+		if err != nil && !errors.Is(err, converter.ErrPrecisionLoss) {
+			t.Fatal(err)
+		}
+
+		checkReference(t, number, from, to, res)
+		checkRoundTrip(t, number, from, to)
+	}
+}
+
+func TestDataSizeConvert_Monotonic(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	names := converter.UnitNames(converter.DataSizeCategory.Name)
+
+	for i := 0; i < 100; i++ {
+		a := math.Ldexp(r.Float64(), r.Intn(100)-50)
+		// b is the closest float64 after a, so the smallest difference is checked as well.
+		for _, b := range []float64{math.Nextafter(a, math.Inf(1)), a * (1 + r.Float64())} {
+			for _, from := range names {
+				for _, to := range names {
+					resA, _ := converter.DataSizeCategory.Convert(types.NumberValue(big.NewFloat(a)), from, to, 0)
+					resB, _ := converter.DataSizeCategory.Convert(types.NumberValue(big.NewFloat(b)), from, to, 0)
+
+					if resA.ValueBigFloat().Cmp(resB.ValueBigFloat()) > 0 {
+						t.Errorf("%s to %s: %v < %v, but %s > %s", from, to, a, b, resA, resB)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+// MaxPrecision is the maximal precision in bits of conversion results.
+const MaxPrecision uint = 4096
+
+var (
+	// ErrUnknownValue is returned when a value to convert is null or not known yet.
+	ErrUnknownValue = errors.New("value is unknown")
+	// ErrNegative is returned when a value of a quantity, which can't be negative, is negative.
+	ErrNegative = errors.New("value must not be negative")
+	// ErrOverflow is returned when a value to convert is infinite.
+	ErrOverflow = errors.New("value is out of range")
+	// ErrUnknownUnit is returned when a unit is not found in a category.
+	ErrUnknownUnit = units.ErrUnknownUnit
+	// ErrPrecisionLoss is returned along with a result, which is rounded to the requested precision.
+	ErrPrecisionLoss = errors.New("result is rounded")
+)
+
+// unitConverter converts number and rounds the result to precision bits.
+// Zero precision keeps the precision of number, but at least units.DefaultPrecision bits.
+//
+// If the result, written in decimal, differs from the exact value, it is returned along with ErrPrecisionLoss.
+type unitConverter func(number types.Number, precision uint) (types.Number, error)
+
+// check validates a value before conversion.
+type check func(value *big.Float) error
+
+func nonNegative(value *big.Float) error {
+	if value.Sign() < 0 {
+		return fmt.Errorf("%w, got: %s", ErrNegative, value.Text('g', -1))
+	}
+
+	return nil
+}
+
+// convert adapts conversion of a number from one unit to another.
+func convert(from, to units.Unit, checks ...check) unitConverter {
+	return newConversion(from, to, checks...).convert
+}
+
+// conversion is a conversion between two units with an exact factor precomputed for linear units.
+type conversion struct {
+	from   units.Unit
+	to     units.Unit
+	checks []check
+
+	// identity is set if both units are the same.
+	identity bool
+	// factor is the exact ratio of a value in to per a value in from, if both units are linear.
+	factor *big.Rat
+	// scale is factor as an exact float, if it is a power of two or ten, e.g. 2^10 or 1/10^3.
+	// Its inverse is stored for factors less than one, so that values are divided by it.
+	scale  *big.Float
+	divide bool
+}
+
+func newConversion(from, to units.Unit, checks ...check) conversion {
+	c := conversion{
+		from:     from,
+		to:       to,
+		checks:   checks,
+		identity: from.Name == to.Name && from.Category == to.Category,
+	}
+	if from.Kind == units.KindLinear && to.Kind == units.KindLinear && units.Compatible(from, to) {
+		c.factor = new(big.Rat).Quo(from.Scale, to.Scale)
+		c.scale, c.divide = scale(c.factor)
+	}
+
+	return c
+}
+
+// scale returns factor as an exact float, if it is a power of two or ten, e.g. 2^-10 or 10^3.
+// An inverse power of ten is returned as its inverse along with true, since values are divided by it.
+// Other factors return nil.
+func scale(factor *big.Rat) (*big.Float, bool) {
+	num, denom := factor.Num(), factor.Denom()
+	if powerOfTwo(num) && powerOfTwo(denom) {
+		return new(big.Float).SetMantExp(big.NewFloat(1), num.BitLen()-denom.BitLen()), false
+	}
+	if num.IsInt64() && num.Int64() == 1 && powerOfTen(denom) {
+		return new(big.Float).SetInt(denom), true
+	}
+	if denom.IsInt64() && denom.Int64() == 1 && powerOfTen(num) {
+		return new(big.Float).SetInt(num), false
+	}
+
+	return nil, false
+}
+
+func powerOfTwo(x *big.Int) bool {
+	return x.Sign() > 0 && x.TrailingZeroBits() == uint(x.BitLen()-1)
+}
+
+func powerOfTen(x *big.Int) bool {
+	ten := big.NewInt(10)
+	q, m := new(big.Int).Set(x), new(big.Int)
+	for q.Cmp(ten) >= 0 {
+		if q.QuoRem(q, ten, m); m.Sign() != 0 {
+			return false
+		}
+	}
+
+	return q.IsInt64() && q.Int64() == 1
+}
+
+func (c conversion) convert(number types.Number, precision uint) (types.Number, error) {
+	if number.IsNull() || number.IsUnknown() {
+		return types.NumberUnknown(), ErrUnknownValue
+	}
+
+	value := number.ValueBigFloat()
+	if value.IsInf() {
+		return types.NumberUnknown(), fmt.Errorf("%w, got: %s", ErrOverflow, value.Text('g', -1))
+	}
+	for _, check := range c.checks {
+		if err := check(value); err != nil {
+			return types.NumberUnknown(), err
+		}
+	}
+
+	// The same value and a zero of linear units are exact in any precision, which fits them.
+	if (c.identity || c.factor != nil && value.Sign() == 0) && (precision == 0 || value.Prec() <= precision) {
+		return number, nil
+	}
+
+	if precision == 0 {
+		precision = max(value.Prec(), units.DefaultPrecision)
+	}
+
+	// Values, which are their own shortest decimals, e.g. 1.5, are multiplied by powers of two and ten as floats,
+	// which are exact results unless they are rounded to precision.
+	if c.scale != nil && units.IsExactDecimal(value) {
+		res := new(big.Float).SetPrec(precision)
+		if c.divide {
+			res.Quo(value, c.scale)
+		} else {
+			res.Mul(value, c.scale)
+		}
+		if res.Acc() == big.Exact && units.IsExactDecimal(res) {
+			return types.NumberValue(res), nil
+		}
+	}
+
+	// The input is taken as the shortest decimal representing it, e.g. 0.1 rather than its binary expansion,
+	// so that decimal inputs converted exactly are not reported as rounded.
+	r, _ := units.Decimal(value)
+	var exact *big.Rat
+	if c.factor != nil {
+		exact = r.Mul(r, c.factor)
+	} else {
+		var err error
+		exact, err = units.Convert(r, c.from, c.to)
+		if err != nil {
+			return types.NumberUnknown(), err
+		}
+	}
+
+	res := new(big.Float).SetPrec(precision).SetRat(exact)
+	if Rounded(res, exact) {
+		return types.NumberValue(res), fmt.Errorf("%w to %d bits of precision: %s", ErrPrecisionLoss, precision, res.Text('g', -1))
+	}
+
+	return types.NumberValue(res), nil
+}
+
+// Rounded reports whether f, written as the shortest decimal representing it, differs from exact.
+func Rounded(f *big.Float, exact *big.Rat) bool {
+	r, ok := units.Decimal(f)
+	return !ok || r.Cmp(exact) != 0
+}
+
+// Round rounds number, written as the shortest decimal representing it, with rounding.
+// The result keeps the precision of number. Null, unknown and infinite numbers are returned as is.
+func Round(number types.Number, rounding units.Rounding) types.Number {
+	if number.IsNull() || number.IsUnknown() {
+		return number
+	}
+
+	value := number.ValueBigFloat()
+	exact, ok := units.Decimal(value)
+	if !ok {
+		return number
+	}
+
+	return types.NumberValue(new(big.Float).SetPrec(value.Prec()).SetRat(rounding.Round(exact)))
+}
+
+// Exact writes r as a decimal if it has a finite decimal representation, e.g. 1.5, or as a fraction otherwise, e.g. 1/3.
+func Exact(r *big.Rat) string {
+	digits, ok := units.DecimalDigits(r)
+	if !ok {
+		return r.RatString()
+	}
+
+	return r.FloatString(digits)
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package converter_test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+)
+
+func TestConverterPrecision(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		convert   func(types.Number, uint) (types.Number, error)
+		value     float64
+		precision uint
+		result    string
+		rounded   bool
+	}{{
+		name:    "default precision",
+		convert: converter.GibibytesFromBytes,
+		value:   1e15,
+		result:  "931322.5746154785",
+		rounded: true,
+	}, {
+		name:      "explicit precision",
+		convert:   converter.GibibytesFromBytes,
+		value:     1e15,
+		precision: 512,
+		result:    "931322.574615478515625",
+	}, {
+		name:    "decimal result",
+		convert: converter.GigabytesFromBytes,
+		value:   1 << 30,
+		result:  "1.073741824",
+	}, {
+		name:      "low precision",
+		convert:   converter.KibibytesToBytes,
+		value:     1000,
+		precision: 4,
+		result:    "1e+06",
+		rounded:   true,
+	}, {
+		name:    "decimal input",
+		convert: converter.GigabytesToBytes,
+		value:   0.1,
+		result:  "1e+08",
+	}, {
+		name:    "decimal binary input",
+		convert: converter.GibibytesToBytes,
+		value:   931.32,
+		result:  "9.9999723552768e+11",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tc.convert(types.NumberValue(big.NewFloat(tc.value)), tc.precision)
+			if s := res.ValueBigFloat().Text('g', -1); s != tc.result {
+				t.Errorf("expected %s, got %s", tc.result, s)
+			}
+			if rounded := errors.Is(err, converter.ErrPrecisionLoss); rounded != tc.rounded {
+				t.Errorf("expected rounded to be %t, got %v", tc.rounded, err)
+			}
+		})
+	}
+}
+
+func TestConverterErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		number  types.Number
+		from    string
+		to      string
+		target  error
+		message string
+	}{{
+		name:    "negative",
+		number:  types.NumberValue(big.NewFloat(-1)),
+		from:    "gibibytes",
+		to:      "bytes",
+		target:  converter.ErrNegative,
+		message: "value must not be negative, got: -1",
+	}, {
+		name:   "unknown",
+		number: types.NumberUnknown(),
+		from:   "gibibytes",
+		to:     "bytes",
+		target: converter.ErrUnknownValue,
+	}, {
+		name:   "infinite",
+		number: types.NumberValue(big.NewFloat(math.Inf(1))),
+		from:   "gibibytes",
+		to:     "bytes",
+		target: converter.ErrOverflow,
+	}, {
+		name:    "unknown unit",
+		number:  types.NumberValue(big.NewFloat(1)),
+		from:    "seconds",
+		to:      "bytes",
+		target:  converter.ErrUnknownUnit,
+		message: `unknown unit "seconds" of data size`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := converter.DataSizeCategory.Convert(tc.number, tc.from, tc.to, 0)
+			if !errors.Is(err, tc.target) {
+				t.Fatalf("expected %v, got %v", tc.target, err)
+			}
+			if tc.message != "" && err.Error() != tc.message {
+				t.Errorf("expected %q, got %q", tc.message, err.Error())
+			}
+		})
+	}
+}
+
+func TestExact(t *testing.T) {
+	for _, tc := range []struct {
+		value    *big.Rat
+		expected string
+	}{
+		{value: big.NewRat(3, 2), expected: "1.5"},
+		{value: big.NewRat(16106127, 10000000), expected: "1.6106127"},
+		{value: big.NewRat(1, 3), expected: "1/3"},
+		{value: big.NewRat(-42, 1), expected: "-42"},
+	} {
+		if actual := converter.Exact(tc.value); actual != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, actual)
+		}
+	}
+}
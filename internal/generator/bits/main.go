@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package bits
+
+import (
+	"math/big"
+
+	"github.com/dstaroff/terraform-provider-units/internal/generator"
+)
+
+var (
+	category = generator.UnitCategory{
+		Title:     "DataSizeBits",
+		Name:      "data_size_bits",
+		Quantity:  "data size in bits",
+		Dimension: "DimensionInformation",
+	}
+
+	// Units of information are scaled relative to bytes, so bits and bytes are convertible to each other.
+	base = generator.ConversionUnit{
+		Title:    "Bits",
+		Name:     "bits",
+		Symbol:   "bit",
+		Category: category.Name,
+		Kind:     generator.ConversionKindLinear,
+		Ratio:    big.NewRat(1, 8),
+	}
+
+	units = []generator.CatalogUnit{{
+		Full:     "kibibits",
+		Symbol:   "Kibit",
+		Synonyms: []string{"kibibit"},
+		Ratio:    bits(generator.Pow(1024, 1)),
+	}, {
+		Full:     "mebibits",
+		Symbol:   "Mibit",
+		Synonyms: []string{"mebibit"},
+		Ratio:    bits(generator.Pow(1024, 2)),
+	}, {
+		Full:     "gibibits",
+		Symbol:   "Gibit",
+		Synonyms: []string{"gibibit"},
+		Ratio:    bits(generator.Pow(1024, 3)),
+	}, {
+		Full:     "tebibits",
+		Symbol:   "Tibit",
+		Synonyms: []string{"tebibit"},
+		Ratio:    bits(generator.Pow(1024, 4)),
+	}, {
+		Full:     "pebibits",
+		Symbol:   "Pibit",
+		Synonyms: []string{"pebibit"},
+		Ratio:    bits(generator.Pow(1024, 5)),
+	}, {
+		Full:     "kilobits",
+		Symbol:   "kbit",
+		Synonyms: []string{"kilobit"},
+		Ratio:    bits(generator.Pow(1000, 1)),
+	}, {
+		Full:     "megabits",
+		Symbol:   "Mbit",
+		Synonyms: []string{"megabit"},
+		Ratio:    bits(generator.Pow(1000, 2)),
+	}, {
+		Full:     "gigabits",
+		Symbol:   "Gbit",
+		Synonyms: []string{"gigabit"},
+		Ratio:    bits(generator.Pow(1000, 3)),
+	}, {
+		Full:     "terabits",
+		Symbol:   "Tbit",
+		Synonyms: []string{"terabit"},
+		Ratio:    bits(generator.Pow(1000, 4)),
+	}, {
+		Full:     "petabits",
+		Symbol:   "Pbit",
+		Synonyms: []string{"petabit"},
+		Ratio:    bits(generator.Pow(1000, 5)),
+	}}
+)
+
+func bits(n *big.Rat) *big.Rat {
+	return new(big.Rat).Mul(n, base.Ratio)
+}
+
+func NewGenerator() *generator.UnitsGenerator {
+	return generator.NewUnitsGenerator(category, base, generator.NewConversionUnits(category, units))
+}
@@ -7,52 +7,109 @@ package datasize
 
 import (
 	"fmt"
+	"math/big"
 	"path/filepath"
-	"strings"
-
-	"github.com/Masterminds/goutils"
 
 	"github.com/dstaroff/terraform-provider-units/internal/generator"
 )
 
 var (
-	units = []struct {
-		Full  string
-		Short string
-	}{{
-		Full:  "kibibytes",
-		Short: "kib",
+	category = generator.UnitCategory{
+		Title:       "DataSize",
+		Name:        "data_size",
+		Quantity:    "data size",
+		Dimension:   "DimensionInformation",
+		NonNegative: true,
+	}
+
+	base = generator.ConversionUnit{
+		Title:    "Bytes",
+		Name:     "bytes",
+		Symbol:   "B",
+		Synonyms: []string{"byte"},
+		Category: category.Name,
+		Kind:     generator.ConversionKindLinear,
+		Ratio:    big.NewRat(1, 1),
+	}
+
+	exampleValue      = big.NewRat(4, 1)
+	guideExampleValue = big.NewRat(3, 2)
+
+	// Functions named after full unit names, e.g. from_kibibytes, are kept for compatibility with short names, e.g. from_kib.
+	units = []generator.CatalogUnit{{
+		Full:     "kibibytes",
+		Short:    "kib",
+		Symbol:   "KiB",
+		Synonyms: []string{"kibibyte", "Ki"},
+		Aliases:  []generator.ConversionAlias{{Name: "kibibytes", Deprecated: true}},
+		Ratio:    generator.Pow(1024, 1),
 	}, {
-		Full:  "mebibytes",
-		Short: "mib",
+		Full:     "mebibytes",
+		Short:    "mib",
+		Symbol:   "MiB",
+		Synonyms: []string{"mebibyte", "Mi"},
+		Aliases:  []generator.ConversionAlias{{Name: "mebibytes", Deprecated: true}},
+		Ratio:    generator.Pow(1024, 2),
 	}, {
-		Full:  "gibibytes",
-		Short: "gib",
+		Full:     "gibibytes",
+		Short:    "gib",
+		Symbol:   "GiB",
+		Synonyms: []string{"gibibyte", "Gi"},
+		Aliases:  []generator.ConversionAlias{{Name: "gibibytes", Deprecated: true}},
+		Ratio:    generator.Pow(1024, 3),
 	}, {
-		Full:  "tebibytes",
-		Short: "tib",
+		Full:     "tebibytes",
+		Short:    "tib",
+		Symbol:   "TiB",
+		Synonyms: []string{"tebibyte", "Ti"},
+		Aliases:  []generator.ConversionAlias{{Name: "tebibytes", Deprecated: true}},
+		Ratio:    generator.Pow(1024, 4),
 	}, {
-		Full:  "pebibytes",
-		Short: "pib",
+		Full:     "pebibytes",
+		Short:    "pib",
+		Symbol:   "PiB",
+		Synonyms: []string{"pebibyte", "Pi"},
+		Aliases:  []generator.ConversionAlias{{Name: "pebibytes", Deprecated: true}},
+		Ratio:    generator.Pow(1024, 5),
 	}, {
-		Full:  "kilobytes",
-		Short: "kb",
+		Full:     "kilobytes",
+		Short:    "kb",
+		Symbol:   "kB",
+		Synonyms: []string{"kilobyte"},
+		Aliases:  []generator.ConversionAlias{{Name: "kilobytes", Deprecated: true}},
+		Ratio:    generator.Pow(1000, 1),
 	}, {
-		Full:  "megabytes",
-		Short: "mb",
+		Full:     "megabytes",
+		Short:    "mb",
+		Symbol:   "MB",
+		Synonyms: []string{"megabyte"},
+		Aliases:  []generator.ConversionAlias{{Name: "megabytes", Deprecated: true}},
+		Ratio:    generator.Pow(1000, 2),
 	}, {
-		Full:  "gigabytes",
-		Short: "gb",
+		Full:     "gigabytes",
+		Short:    "gb",
+		Symbol:   "GB",
+		Synonyms: []string{"gigabyte"},
+		Aliases:  []generator.ConversionAlias{{Name: "gigabytes", Deprecated: true}},
+		Ratio:    generator.Pow(1000, 3),
 	}, {
-		Full:  "terabytes",
-		Short: "tb",
+		Full:     "terabytes",
+		Short:    "tb",
+		Symbol:   "TB",
+		Synonyms: []string{"terabyte"},
+		Aliases:  []generator.ConversionAlias{{Name: "terabytes", Deprecated: true}},
+		Ratio:    generator.Pow(1000, 4),
 	}, {
-		Full:  "petabytes",
-		Short: "pb",
+		Full:     "petabytes",
+		Short:    "pb",
+		Symbol:   "PB",
+		Synonyms: []string{"petabyte"},
+		Aliases:  []generator.ConversionAlias{{Name: "petabytes", Deprecated: true}},
+		Ratio:    generator.Pow(1000, 5),
 	}}
 )
 
-var _ generator.Generator = &Generator{}
+var _ generator.ProviderGenerator = &Generator{}
 
 type Generator struct {
 	generator.Base
@@ -64,82 +121,101 @@ func NewGenerator() *Generator {
 	}
 }
 
-func (g *Generator) GenerateFunctions() (functionConstructorNames []string) {
-	var directions []generator.ConversionDirection
-	{
-		dirFrom := generator.ConversionDirection{
-			Title: "From",
-			Name:  "from",
-		}
-		dirTo := generator.ConversionDirection{
-			Title: "To",
-			Name:  "to",
-		}
-		dirFrom.Opposite = &dirTo
-		dirTo.Opposite = &dirFrom
-
-		directions = append(directions, dirFrom, dirTo)
-	}
-
-	var functions []generator.Function
-	for _, unit := range units {
-		functions = append(functions, generator.Function{
-			Conversion: generator.Conversion{
-				Unit: generator.ConversionUnit{
-					Title: goutils.CapitalizeFully(unit.Full),
-					Name:  unit.Full,
-					Short: strings.ToLower(unit.Short),
-				},
-				Directions: directions,
-			},
-			CopyrightInfo: g.CopyrightInfo,
-		})
-	}
+func (g *Generator) GenerateFunctionExamples() {
+	directions := []generator.ConversionDirection{{
+		Title: "From",
+		Name:  "from",
+	}, {
+		Title: "To",
+		Name:  "to",
+	}}
 
-	for _, function := range functions {
-		g.Generate(
-			filepath.Join(generator.PathDirFunctionsGenerated, fmt.Sprintf("data_size_%s.go", function.Conversion.Unit.Name)),
-			filepath.Join(generator.PathDirTemplates, "data_size_function.go.gotmpl"),
-			function,
-		)
-		for _, direction := range function.Conversion.Directions {
-			functionConstructorNames = append(
-				functionConstructorNames,
-				fmt.Sprintf("New%s%sModel", direction.Title, function.Conversion.Unit.Title),
-			)
+	for _, unit := range g.conversionUnits() {
+		names := []string{unit.Short}
+		for _, alias := range unit.Aliases {
+			names = append(names, alias.Name)
 		}
 
 		for _, direction := range directions {
-			function.Conversion.Directions = []generator.ConversionDirection{
-				direction,
+			for _, name := range names {
+				example := generator.NewFunctionExample(fmt.Sprintf("%s_%s", direction.Name, name), unit, base, direction, exampleValue)
+				g.Generate(
+					filepath.Join(generator.PathDirFunctionExamples, example.Name, "function.tf"),
+					filepath.Join(generator.PathDirTemplates, "data_size_function_example.tf.gotmpl"),
+					example,
+				)
 			}
-			g.Generate(
-				filepath.Join(generator.PathDirFunctionExamples, fmt.Sprintf("%s_%s", direction.Name, function.Conversion.Unit.Short), "function.tf"),
-				filepath.Join(generator.PathDirTemplates, "data_size_function_example.tf.gotmpl"),
-				function,
-			)
 		}
 	}
+}
 
-	return functionConstructorNames
+func (g *Generator) GenerateUnitDefinitions() generator.UnitCategory {
+	g.GenerateUnits(category, base, g.conversionUnits())
+
+	return category
 }
 
-func (g *Generator) GenerateConverterNames() {
-	data := generator.Units{
-		UnitCategory: generator.UnitCategory{
-			Title: "DataSize",
-			Name:  "data_size",
-		},
-		Names:         []string{"bytes"},
+func (g *Generator) GenerateConverters() generator.UnitCategory {
+	data := generator.Converters{
+		UnitCategory:  category,
+		Base:          base,
+		Units:         g.conversionUnits(),
 		CopyrightInfo: g.CopyrightInfo,
 	}
-	for _, unit := range units {
-		data.Names = append(data.Names, unit.Full)
-	}
 
 	g.Generate(
-		filepath.Join(generator.PathDirConverter, fmt.Sprintf("converter_%s_names.go", data.UnitCategory.Name)),
-		filepath.Join(generator.PathDirTemplates, "converter_names.go.gotmpl"),
+		filepath.Join(generator.PathDirConverter, fmt.Sprintf("converter_%s_converters.go", data.UnitCategory.Name)),
+		filepath.Join(generator.PathDirTemplates, "converters.go.gotmpl"),
 		data,
 	)
+
+	return data.UnitCategory
+}
+
+func (g *Generator) GenerateGuide() {
+	g.WriteGuide(generator.NewGuide(category, base, g.conversionUnits(), guideExampleValue, true))
+}
+
+func (g *Generator) GenerateDataSourceExamples() {
+	g.Generate(
+		filepath.Join(generator.PathDirDataSourceExamples, fmt.Sprintf("units_%s", category.Name), "data-source.tf"),
+		filepath.Join(generator.PathDirTemplates, "data_source_example.tf.gotmpl"),
+		generator.DataSourceExamples{
+			UnitCategory: category,
+			Examples: []generator.DataSourceExample{{
+				Name:   "disk_size",
+				Input:  "gigabytes",
+				Value:  "1000",
+				Output: "real_disk_size",
+				Result: "gibibytes",
+			}, {
+				Name:   "fs_block_size",
+				Input:  "kibibytes",
+				Value:  "4",
+				Output: "full_fs_block_size",
+				Result: "kibibytes",
+			}, {
+				Name:   "memory",
+				Input:  "value",
+				Value:  `"20GiB"`,
+				Output: "memory_bytes",
+				Result: "bytes",
+			}, {
+				Name:   "volume_size",
+				Input:  "gibibytes",
+				Value:  "1.5",
+				Output: "volume_size_gb",
+				Result: "gigabytes",
+				Arguments: []generator.DataSourceArgument{
+					{Name: "decimal_places", Value: "0"},
+					{Name: "rounding_mode", Value: `"ceiling"`},
+					{Name: "require_integer_units", Value: `["GB"]`},
+				},
+			}},
+		},
+	)
+}
+
+func (_ *Generator) conversionUnits() []generator.ConversionUnit {
+	return generator.NewConversionUnits(category, units)
 }
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package generator
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+// NewFunctionExample builds an example of a function converting value of unit from or to the base unit.
+func NewFunctionExample(name string, unit, base ConversionUnit, direction ConversionDirection, value *big.Rat) FunctionExample {
+	inBase := new(big.Rat).Mul(value, unit.Ratio)
+
+	if direction.Name == "to" {
+		return FunctionExample{
+			Name:         name,
+			Direction:    direction,
+			ArgumentUnit: base,
+			Argument:     FormatRat(inBase),
+			ResultUnit:   unit,
+			Result:       FormatRat(value),
+		}
+	}
+
+	return FunctionExample{
+		Name:         name,
+		Direction:    direction,
+		ArgumentUnit: unit,
+		Argument:     FormatRat(value),
+		ResultUnit:   base,
+		Result:       FormatRat(inBase),
+	}
+}
+
+// Assignments writes the input and the arguments of the example aligned by the equals sign, as terraform fmt does.
+func (e DataSourceExample) Assignments() []string {
+	arguments := append([]DataSourceArgument{{Name: e.Input, Value: e.Value}}, e.Arguments...)
+
+	width := 0
+	for _, argument := range arguments {
+		width = max(width, len(argument.Name))
+	}
+
+	assignments := make([]string, 0, len(arguments))
+	for _, argument := range arguments {
+		assignments = append(assignments, fmt.Sprintf("%-*s = %s", width, argument.Name, argument.Value))
+	}
+
+	return assignments
+}
+
+// NewGuide builds a documentation page of a category, whose worked examples convert value of every unit.
+// Functions of units, including their aliases, are listed if functions is set.
+func NewGuide(category UnitCategory, base ConversionUnit, units []ConversionUnit, value *big.Rat, functions bool) Guide {
+	guide := Guide{
+		UnitCategory: category,
+		Title:        strings.ToUpper(category.Quantity[:1]) + category.Quantity[1:],
+		Base:         base,
+		ExampleValue: FormatRat(value),
+		Functions:    functions,
+	}
+
+	for _, unit := range append([]ConversionUnit{base}, units...) {
+		guideUnit := GuideUnit{Unit: unit}
+		if functions && unit.Name != base.Name {
+			guideUnit.Functions = append(guideUnit.Functions, GuideFunction{Name: "from_" + unit.Short}, GuideFunction{Name: "to_" + unit.Short})
+			for _, alias := range unit.Aliases {
+				guideUnit.Functions = append(guideUnit.Functions,
+					GuideFunction{Name: "from_" + alias.Name, Deprecated: alias.Deprecated},
+					GuideFunction{Name: "to_" + alias.Name, Deprecated: alias.Deprecated},
+				)
+			}
+		}
+
+		switch {
+		case unit.Kind.IsLinear():
+			guideUnit.Factor = FormatRat(unit.Ratio)
+			guideUnit.Example = FormatRat(new(big.Rat).Mul(value, unit.Ratio))
+		case unit.Kind.IsAffine():
+			inBase := new(big.Rat).Mul(value, unit.Ratio)
+			guideUnit.Example = FormatRat(inBase.Add(inBase, unit.Offset))
+		case unit.Kind.IsLogarithmic():
+			// Logarithmic units are converted with float64 precision, so their examples are approximate.
+			v, _ := value.Float64()
+			factor, _ := unit.Factor.Float64()
+			reference, _ := unit.Reference.Float64()
+			guideUnit.Example = "≈ " + strconv.FormatFloat(reference*math.Pow(10, v/factor), 'g', 6, 64)
+		}
+
+		guide.Units = append(guide.Units, guideUnit)
+	}
+
+	return guide
+}
+
+// FormatRat formats r as an exact decimal number if it has a finite decimal representation,
+// and as a fraction otherwise.
+func FormatRat(r *big.Rat) string {
+	digits, ok := units.DecimalDigits(r)
+	if !ok {
+		return r.RatString()
+	}
+
+	return r.FloatString(digits)
+}
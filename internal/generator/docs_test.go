@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package generator_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dstaroff/terraform-provider-units/internal/generator"
+)
+
+func TestNewGuide_DeprecatedAlias(t *testing.T) {
+	category := generator.UnitCategory{Title: "DataSize", Name: "data_size", Quantity: "data size"}
+	base := generator.ConversionUnit{Name: "bytes", Symbol: "B", Kind: generator.ConversionKindLinear, Ratio: big.NewRat(1, 1)}
+	unit := generator.NewConversionUnit(category, generator.CatalogUnit{
+		Full:     "kibibytes",
+		Short:    "kib",
+		Symbol:   "KiB",
+		Synonyms: []string{"kibibyte", "Ki"},
+		Aliases:  []generator.ConversionAlias{{Name: "kibibytes", Deprecated: true}},
+		Ratio:    generator.Pow(1024, 1),
+	})
+
+	guide := generator.NewGuide(category, base, []generator.ConversionUnit{unit}, big.NewRat(3, 2), true)
+
+	var deprecated []string
+	for _, function := range guide.Units[1].Functions {
+		if function.Deprecated {
+			deprecated = append(deprecated, function.Name)
+		}
+	}
+	if strings.Join(deprecated, ",") != "from_kibibytes,to_kibibytes" {
+		t.Errorf("unexpected deprecated functions %v", deprecated)
+	}
+
+	filename := filepath.Join(t.TempDir(), "data_size.md")
+	generator.NewBase().Generate(filename, filepath.Join(generator.PathDirTemplates, "category_guide.md.gotmpl"), guide)
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		"| `kibibytes` | `KiB` | `kibibyte`, `Ki` |",
+		"[`from_kib`](../functions/from_kib.md), [`to_kib`](../functions/to_kib.md),",
+		"[`from_kibibytes`](../functions/from_kibibytes.md) (deprecated)",
+		"[`to_kibibytes`](../functions/to_kibibytes.md) (deprecated)",
+		"| `1.5` KiB | `1536` |",
+	} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("expected the guide to contain %q, got:\n%s", expected, content)
+		}
+	}
+}
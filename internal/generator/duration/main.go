@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package duration
+
+import (
+	"math/big"
+
+	"github.com/dstaroff/terraform-provider-units/internal/generator"
+)
+
+var (
+	category = generator.UnitCategory{
+		Title:     "Duration",
+		Name:      "duration",
+		Quantity:  "duration",
+		Dimension: "DimensionTime",
+	}
+
+	base = generator.ConversionUnit{
+		Title:    "Seconds",
+		Name:     "seconds",
+		Symbol:   "s",
+		Synonyms: []string{"second", "sec"},
+		Category: category.Name,
+		Kind:     generator.ConversionKindLinear,
+		Ratio:    big.NewRat(1, 1),
+	}
+
+	units = []generator.CatalogUnit{{
+		Full:     "nanoseconds",
+		Symbol:   "ns",
+		Synonyms: []string{"nanosecond"},
+		Ratio:    new(big.Rat).Inv(generator.Pow(1000, 3)),
+	}, {
+		Full:     "microseconds",
+		Symbol:   "µs",
+		Synonyms: []string{"microsecond", "us"},
+		Ratio:    new(big.Rat).Inv(generator.Pow(1000, 2)),
+	}, {
+		Full:     "milliseconds",
+		Symbol:   "ms",
+		Synonyms: []string{"millisecond"},
+		Ratio:    new(big.Rat).Inv(generator.Pow(1000, 1)),
+	}, {
+		Full:     "minutes",
+		Symbol:   "min",
+		Synonyms: []string{"minute"},
+		Ratio:    big.NewRat(60, 1),
+	}, {
+		Full:     "hours",
+		Symbol:   "h",
+		Synonyms: []string{"hour", "hr"},
+		Ratio:    big.NewRat(60*60, 1),
+	}, {
+		Full:     "days",
+		Symbol:   "d",
+		Synonyms: []string{"day"},
+		Ratio:    big.NewRat(24*60*60, 1),
+	}, {
+		Full:     "weeks",
+		Symbol:   "wk",
+		Synonyms: []string{"week"},
+		Ratio:    big.NewRat(7*24*60*60, 1),
+	}, {
+		// A month is a twelfth of the mean Gregorian year, as used for billing in GB·month.
+		Full:     "months",
+		Symbol:   "mo",
+		Synonyms: []string{"month"},
+		Ratio:    big.NewRat(2_629_746, 1),
+	}, {
+		// A year is the mean Gregorian year of 365.2425 days.
+		Full:     "years",
+		Symbol:   "yr",
+		Synonyms: []string{"year"},
+		Ratio:    big.NewRat(31_556_952, 1),
+	}}
+)
+
+func NewGenerator() *generator.UnitsGenerator {
+	return generator.NewUnitsGenerator(category, base, generator.NewConversionUnits(category, units))
+}
@@ -8,8 +8,11 @@ package generator
 import (
 	"bytes"
 	"crypto/sha256"
+	"fmt"
+	"go/format"
 	"io"
 	"log"
+	"math/big"
 	"os"
 	"path/filepath"
 	"strings"
@@ -18,44 +21,126 @@ import (
 )
 
 type (
-	Function struct {
-		Conversion    Conversion
-		CopyrightInfo copyrightInfo
+	ConversionUnit struct {
+		Title    string
+		Name     string
+		Short    string
+		Symbol   string
+		Category string
+		Aliases  []ConversionAlias
+		Kind     ConversionKind
+
+		// Synonyms are other names the unit is looked up by, e.g. a singular form.
+		Synonyms      []string
+		CaseSensitive bool
+
+		// Ratio, Offset, Reference and Factor are exact parameters of the unit. Which of them are used depends on Kind.
+		Ratio     *big.Rat
+		Offset    *big.Rat
+		Reference *big.Rat
+		Factor    *big.Rat
 	}
-	Conversion struct {
-		Unit       ConversionUnit
-		Directions []ConversionDirection
+	ConversionAlias struct {
+		Title      string
+		Name       string
+		Deprecated bool
 	}
-	ConversionUnit struct {
+	ConversionDirection struct {
 		Title string
 		Name  string
-		Short string
 	}
-	ConversionDirection struct {
-		Title    string
-		Name     string
-		Opposite *ConversionDirection
+
+	FunctionExample struct {
+		Name         string
+		Direction    ConversionDirection
+		ArgumentUnit ConversionUnit
+		Argument     string
+		ResultUnit   ConversionUnit
+		Result       string
 	}
 
-	GeneratedFunctions struct {
-		Names         []string
+	DataSourceExamples struct {
+		UnitCategory UnitCategory
+		Examples     []DataSourceExample
+	}
+	DataSourceExample struct {
+		Name   string
+		Input  string
+		Value  string
+		Output string
+		Result string
+
+		// Arguments are set after Input, e.g. settings of the data source.
+		Arguments []DataSourceArgument
+	}
+	DataSourceArgument struct {
+		Name  string
+		Value string
+	}
+
+	Guide struct {
+		UnitCategory UnitCategory
+		Title        string
+		Base         ConversionUnit
+		Units        []GuideUnit
+		ExampleValue string
+		// Functions is set for categories exposed as provider functions and a data source of their own.
+		Functions bool
+	}
+	GuideUnit struct {
+		Unit      ConversionUnit
+		Functions []GuideFunction
+		// Factor is empty for units, which are not proportional to the base unit.
+		Factor  string
+		Example string
+	}
+	GuideFunction struct {
+		Name       string
+		Deprecated bool
+	}
+
+	Catalog struct {
+		Categories    []UnitCategory
 		CopyrightInfo copyrightInfo
 	}
 
-	Units struct {
+	UnitDefinitions struct {
 		UnitCategory  UnitCategory
-		Names         []string
+		Base          ConversionUnit
+		Units         []ConversionUnit
 		CopyrightInfo copyrightInfo
 	}
+
 	UnitCategory struct {
-		Title string
-		Name  string
+		Title    string
+		Name     string
+		Quantity string
+		// Dimension is a Go expression of type units.Dimension within the units package.
+		Dimension string
+		// NonNegative is set for quantities which can't be negative, e.g. data size.
+		NonNegative bool
+	}
+
+	Converters struct {
+		UnitCategory  UnitCategory
+		Base          ConversionUnit
+		Units         []ConversionUnit
+		CopyrightInfo copyrightInfo
 	}
 )
 
+// Generator generates definitions of units of a category along with its guide.
 type Generator interface {
-	GenerateFunctions() (functionConstructorNames []string)
-	GenerateConverterNames()
+	GenerateUnitDefinitions() UnitCategory
+	GenerateGuide()
+}
+
+// ProviderGenerator generates provider functions of a category along with their docs and examples.
+type ProviderGenerator interface {
+	Generator
+	GenerateFunctionExamples()
+	GenerateDataSourceExamples()
+	GenerateConverters() UnitCategory
 }
 
 type copyrightInfo struct {
@@ -76,25 +161,33 @@ func NewBase() Base {
 	}
 }
 
-func (b Base) Generate(filename string, templatePath string, data any) {
+func (b Base) Generate(filename string, templatePath string, data any, partialPaths ...string) {
 	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
 		log.Fatal(err)
 	}
 
 	checksumBefore := b.getFileChecksum(filename)
 
-	file, err := os.Create(filename)
+	t, err := template.ParseFiles(append([]string{templatePath}, partialPaths...)...)
 	if err != nil {
 		log.Fatal(err)
 	}
-	defer file.Close()
 
-	t, err := template.ParseFiles(templatePath)
+	var buf bytes.Buffer
+	err = t.Execute(&buf, data)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	err = t.Execute(file, data)
+	content := buf.Bytes()
+	if filepath.Ext(filename) == ".go" {
+		content, err = format.Source(content)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	err = os.WriteFile(filename, content, 0644)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -121,12 +214,45 @@ func (_ Base) getFileChecksum(filename string) []byte {
 	return hasher.Sum(nil)
 }
 
-func (b Base) GenerateGeneratedFunctions(functionConstructorNames []string) {
+// GenerateUnits generates definitions of base and units of category in the units package.
+func (b Base) GenerateUnits(category UnitCategory, base ConversionUnit, units []ConversionUnit) {
+	b.Generate(
+		filepath.Join(PathDirUnits, fmt.Sprintf("%s.go", category.Name)),
+		filepath.Join(PathDirTemplates, "units.go.gotmpl"),
+		UnitDefinitions{
+			UnitCategory:  category,
+			Base:          base,
+			Units:         append([]ConversionUnit{base}, units...),
+			CopyrightInfo: b.CopyrightInfo,
+		},
+		UnitKindTemplates()...,
+	)
+}
+
+// WriteGuide generates the template of the documentation page of the category of guide.
+func (b Base) WriteGuide(guide Guide) {
+	b.Generate(
+		filepath.Join(PathDirGuideTemplates, fmt.Sprintf("%s.md.tmpl", guide.UnitCategory.Name)),
+		filepath.Join(PathDirTemplates, "category_guide.md.gotmpl"),
+		guide,
+	)
+}
+
+// GenerateCatalog generates registries of all unit categories and of the categories exposed as provider functions.
+func (b Base) GenerateCatalog(unitCategories, converterCategories []UnitCategory) {
+	b.Generate(
+		filepath.Join(PathDirUnits, "catalog.go"),
+		filepath.Join(PathDirTemplates, "units_catalog.go.gotmpl"),
+		Catalog{
+			Categories:    unitCategories,
+			CopyrightInfo: b.CopyrightInfo,
+		},
+	)
 	b.Generate(
-		filepath.Join(PathDirFunctions, "generated.go"),
-		filepath.Join(PathDirTemplates, "generated_functions.go.gotmpl"),
-		GeneratedFunctions{
-			Names:         functionConstructorNames,
+		filepath.Join(PathDirConverter, "converter_catalog.go"),
+		filepath.Join(PathDirTemplates, "converter_catalog.go.gotmpl"),
+		Catalog{
+			Categories:    converterCategories,
 			CopyrightInfo: b.CopyrightInfo,
 		},
 	)
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package generator
+
+import (
+	"path/filepath"
+)
+
+// ConversionKind defines how a unit relates to the base unit of its category.
+type ConversionKind string
+
+const (
+	// ConversionKindLinear is base = value * Ratio.
+	ConversionKindLinear ConversionKind = "linear"
+	// ConversionKindAffine is base = value * Ratio + Offset.
+	ConversionKindAffine ConversionKind = "affine"
+	// ConversionKindLogarithmic is value = Factor * log10(base / Reference).
+	ConversionKindLogarithmic ConversionKind = "logarithmic"
+)
+
+func (k ConversionKind) IsLinear() bool {
+	return k == ConversionKindLinear
+}
+
+func (k ConversionKind) IsAffine() bool {
+	return k == ConversionKindAffine
+}
+
+func (k ConversionKind) IsLogarithmic() bool {
+	return k == ConversionKindLogarithmic
+}
+
+// UnitKindTemplates returns paths to partial templates rendering unit definitions of every ConversionKind.
+func UnitKindTemplates() []string {
+	var paths []string
+	for _, kind := range []ConversionKind{
+		ConversionKindLinear,
+		ConversionKindAffine,
+		ConversionKindLogarithmic,
+	} {
+		paths = append(paths, filepath.Join(PathDirUnitsTemplates, string(kind)+".go.gotmpl"))
+	}
+
+	return paths
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package length
+
+import (
+	"math/big"
+
+	"github.com/dstaroff/terraform-provider-units/internal/generator"
+)
+
+var (
+	category = generator.UnitCategory{
+		Title:     "Length",
+		Name:      "length",
+		Quantity:  "length",
+		Dimension: "DimensionLength",
+	}
+
+	base = generator.ConversionUnit{
+		Title:    "Meters",
+		Name:     "meters",
+		Symbol:   "m",
+		Synonyms: []string{"meter", "metre", "metres"},
+		Category: category.Name,
+		Kind:     generator.ConversionKindLinear,
+		Ratio:    big.NewRat(1, 1),
+	}
+
+	units = []generator.CatalogUnit{{
+		Full:     "nanometers",
+		Symbol:   "nm",
+		Synonyms: []string{"nanometer"},
+		Ratio:    new(big.Rat).Inv(generator.Pow(1000, 3)),
+	}, {
+		Full:     "micrometers",
+		Symbol:   "µm",
+		Synonyms: []string{"micrometer"},
+		Ratio:    new(big.Rat).Inv(generator.Pow(1000, 2)),
+	}, {
+		Full:     "millimeters",
+		Symbol:   "mm",
+		Synonyms: []string{"millimeter"},
+		Ratio:    new(big.Rat).Inv(generator.Pow(1000, 1)),
+	}, {
+		Full:     "centimeters",
+		Symbol:   "cm",
+		Synonyms: []string{"centimeter"},
+		Ratio:    big.NewRat(1, 100),
+	}, {
+		Full:     "kilometers",
+		Symbol:   "km",
+		Synonyms: []string{"kilometer"},
+		Ratio:    generator.Pow(1000, 1),
+	}}
+)
+
+func NewGenerator() *generator.UnitsGenerator {
+	return generator.NewUnitsGenerator(category, base, generator.NewConversionUnits(category, units))
+}
@@ -9,24 +9,44 @@ package main
 
 import (
 	"github.com/dstaroff/terraform-provider-units/internal/generator"
+	"github.com/dstaroff/terraform-provider-units/internal/generator/bits"
 	"github.com/dstaroff/terraform-provider-units/internal/generator/datasize"
+	"github.com/dstaroff/terraform-provider-units/internal/generator/duration"
+	"github.com/dstaroff/terraform-provider-units/internal/generator/length"
+	"github.com/dstaroff/terraform-provider-units/internal/generator/mass"
+	"github.com/dstaroff/terraform-provider-units/internal/generator/power"
+	"github.com/dstaroff/terraform-provider-units/internal/generator/resistance"
+	"github.com/dstaroff/terraform-provider-units/internal/generator/temperature"
 )
 
 var (
 	generators = []generator.Generator{
 		datasize.NewGenerator(),
+		duration.NewGenerator(),
+		bits.NewGenerator(),
+		length.NewGenerator(),
+		mass.NewGenerator(),
+		temperature.NewGenerator(),
+		resistance.NewGenerator(),
+		power.NewGenerator(),
 	}
 )
 
 func main() {
-	var functionConstructorNames []string
+	var unitCategories, converterCategories []generator.UnitCategory
 
 	for _, g := range generators {
-		functionConstructorNames = append(functionConstructorNames, g.GenerateFunctions()...)
-		g.GenerateConverterNames()
+		unitCategories = append(unitCategories, g.GenerateUnitDefinitions())
+		g.GenerateGuide()
+
+		if g, ok := g.(generator.ProviderGenerator); ok {
+			g.GenerateFunctionExamples()
+			g.GenerateDataSourceExamples()
+			converterCategories = append(converterCategories, g.GenerateConverters())
+		}
 	}
 
-	generator.NewBase().GenerateGeneratedFunctions(functionConstructorNames)
+	generator.NewBase().GenerateCatalog(unitCategories, converterCategories)
 }
 
 //go:generate go run ./${GOFILE}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package mass
+
+import (
+	"math/big"
+
+	"github.com/dstaroff/terraform-provider-units/internal/generator"
+)
+
+var (
+	category = generator.UnitCategory{
+		Title:     "Mass",
+		Name:      "mass",
+		Quantity:  "mass",
+		Dimension: "DimensionMass",
+	}
+
+	base = generator.ConversionUnit{
+		Title:    "Kilograms",
+		Name:     "kilograms",
+		Symbol:   "kg",
+		Synonyms: []string{"kilogram"},
+		Category: category.Name,
+		Kind:     generator.ConversionKindLinear,
+		Ratio:    big.NewRat(1, 1),
+	}
+
+	units = []generator.CatalogUnit{{
+		Full:     "milligrams",
+		Symbol:   "mg",
+		Synonyms: []string{"milligram"},
+		Ratio:    new(big.Rat).Inv(generator.Pow(1000, 2)),
+	}, {
+		Full:     "grams",
+		Symbol:   "g",
+		Synonyms: []string{"gram"},
+		Ratio:    new(big.Rat).Inv(generator.Pow(1000, 1)),
+	}, {
+		Full:     "tonnes",
+		Symbol:   "t",
+		Synonyms: []string{"tonne"},
+		Ratio:    generator.Pow(1000, 1),
+	}}
+)
+
+func NewGenerator() *generator.UnitsGenerator {
+	return generator.NewUnitsGenerator(category, base, generator.NewConversionUnits(category, units))
+}
@@ -10,18 +10,24 @@ import (
 )
 
 var (
-	PathDirRoot      string
-	PathDirTemplates string
-
-	PathDirExamples         string
-	PathDirFunctionExamples string
-
-	PathDirInternal           string
-	PathDirConverter          string
-	PathDirProvider           string
-	PathDirDataSources        string
-	PathDirFunctions          string
-	PathDirFunctionsGenerated string
+	PathDirRoot           string
+	PathDirTemplates      string
+	PathDirUnitsTemplates string
+
+	PathDirExamples           string
+	PathDirFunctionExamples   string
+	PathDirDataSourceExamples string
+
+	PathDirDocTemplates   string
+	PathDirGuideTemplates string
+
+	PathDirUnits string
+
+	PathDirInternal    string
+	PathDirConverter   string
+	PathDirProvider    string
+	PathDirDataSources string
+	PathDirFunctions   string
 )
 
 func init() {
@@ -39,13 +45,20 @@ func init() {
 		}
 	}
 
+	PathDirUnitsTemplates = filepath.Join(PathDirTemplates, "units")
+
 	PathDirExamples = filepath.Join(PathDirRoot, "examples")
 	PathDirFunctionExamples = filepath.Join(PathDirExamples, "functions")
+	PathDirDataSourceExamples = filepath.Join(PathDirExamples, "data-sources")
+
+	PathDirDocTemplates = filepath.Join(PathDirRoot, "templates")
+	PathDirGuideTemplates = filepath.Join(PathDirDocTemplates, "guides")
+
+	PathDirUnits = filepath.Join(PathDirRoot, "pkg", "units")
 
 	PathDirInternal = filepath.Join(PathDirRoot, "internal")
 	PathDirConverter = filepath.Join(PathDirInternal, "converter")
 	PathDirProvider = filepath.Join(PathDirInternal, "provider")
 	PathDirDataSources = filepath.Join(PathDirProvider, "datasource")
 	PathDirFunctions = filepath.Join(PathDirProvider, "function")
-	PathDirFunctionsGenerated = filepath.Join(PathDirFunctions, "generated")
 }
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package power
+
+import (
+	"math/big"
+
+	"github.com/dstaroff/terraform-provider-units/internal/generator"
+)
+
+var (
+	// The category is titled after electric power, since Power is the type of powers of compound units.
+	category = generator.UnitCategory{
+		Title:     "ElectricPower",
+		Name:      "power",
+		Quantity:  "power",
+		Dimension: "Dimension{BaseMass: 1, BaseLength: 2, BaseTime: -3}",
+	}
+
+	// Symbols of power are case-sensitive to tell milliwatts from megawatts.
+	base = generator.ConversionUnit{
+		Title:         "Watts",
+		Name:          "watts",
+		Symbol:        "W",
+		Synonyms:      []string{"watt"},
+		Category:      category.Name,
+		Kind:          generator.ConversionKindLinear,
+		Ratio:         big.NewRat(1, 1),
+		CaseSensitive: true,
+	}
+
+	units = []generator.CatalogUnit{{
+		Full:          "milliwatts",
+		Symbol:        "mW",
+		Synonyms:      []string{"milliwatt"},
+		Ratio:         new(big.Rat).Inv(generator.Pow(1000, 1)),
+		CaseSensitive: true,
+	}, {
+		Full:          "kilowatts",
+		Symbol:        "kW",
+		Synonyms:      []string{"kilowatt"},
+		Ratio:         generator.Pow(1000, 1),
+		CaseSensitive: true,
+	}, {
+		Full:          "megawatts",
+		Symbol:        "MW",
+		Synonyms:      []string{"megawatt"},
+		Ratio:         generator.Pow(1000, 2),
+		CaseSensitive: true,
+	}}
+
+	// Decibel units are ten times the logarithm of the power ratio to their reference, so they are logarithmic.
+	decibels = []generator.ConversionUnit{{
+		Title:     "DecibelMilliwatts",
+		Name:      "decibel-milliwatts",
+		Symbol:    "dBm",
+		Synonyms:  []string{"decibel-milliwatt"},
+		Category:  category.Name,
+		Kind:      generator.ConversionKindLogarithmic,
+		Reference: new(big.Rat).Inv(generator.Pow(1000, 1)),
+		Factor:    big.NewRat(10, 1),
+	}, {
+		Title:     "DecibelWatts",
+		Name:      "decibel-watts",
+		Symbol:    "dBW",
+		Synonyms:  []string{"decibel-watt"},
+		Category:  category.Name,
+		Kind:      generator.ConversionKindLogarithmic,
+		Reference: big.NewRat(1, 1),
+		Factor:    big.NewRat(10, 1),
+	}}
+)
+
+func NewGenerator() *generator.UnitsGenerator {
+	return generator.NewUnitsGenerator(category, base, append(generator.NewConversionUnits(category, units), decibels...))
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package resistance
+
+import (
+	"math/big"
+
+	"github.com/dstaroff/terraform-provider-units/internal/generator"
+)
+
+var (
+	category = generator.UnitCategory{
+		Title:     "Resistance",
+		Name:      "resistance",
+		Quantity:  "electrical resistance",
+		Dimension: "Dimension{BaseMass: 1, BaseLength: 2, BaseTime: -3, BaseCurrent: -2}",
+	}
+
+	// Symbols of resistance are case-sensitive to tell milliohms from megaohms.
+	base = generator.ConversionUnit{
+		Title:         "Ohms",
+		Name:          "ohms",
+		Symbol:        "Ω",
+		Synonyms:      []string{"ohm"},
+		Category:      category.Name,
+		Kind:          generator.ConversionKindLinear,
+		Ratio:         big.NewRat(1, 1),
+		CaseSensitive: true,
+	}
+
+	units = []generator.CatalogUnit{{
+		Full:          "milliohms",
+		Symbol:        "mΩ",
+		Synonyms:      []string{"milliohm"},
+		Ratio:         new(big.Rat).Inv(generator.Pow(1000, 1)),
+		CaseSensitive: true,
+	}, {
+		Full:          "kiloohms",
+		Symbol:        "kΩ",
+		Synonyms:      []string{"kiloohm", "kilohms", "kilohm"},
+		Ratio:         generator.Pow(1000, 1),
+		CaseSensitive: true,
+	}, {
+		Full:          "megaohms",
+		Symbol:        "MΩ",
+		Synonyms:      []string{"megaohm", "megohms", "megohm"},
+		Ratio:         generator.Pow(1000, 2),
+		CaseSensitive: true,
+	}}
+)
+
+func NewGenerator() *generator.UnitsGenerator {
+	return generator.NewUnitsGenerator(category, base, generator.NewConversionUnits(category, units))
+}
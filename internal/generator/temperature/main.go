@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package temperature
+
+import (
+	"math/big"
+
+	"github.com/dstaroff/terraform-provider-units/internal/generator"
+)
+
+var (
+	category = generator.UnitCategory{
+		Title:     "Temperature",
+		Name:      "temperature",
+		Quantity:  "temperature",
+		Dimension: "DimensionTemperature",
+	}
+
+	base = generator.ConversionUnit{
+		Title:    "Kelvins",
+		Name:     "kelvins",
+		Symbol:   "K",
+		Synonyms: []string{"kelvin"},
+		Category: category.Name,
+		Kind:     generator.ConversionKindLinear,
+		Ratio:    big.NewRat(1, 1),
+	}
+
+	// Celsius and Fahrenheit scales are offset from the absolute zero, so they are affine.
+	units = []generator.ConversionUnit{{
+		Title:    "Celsius",
+		Name:     "celsius",
+		Symbol:   "°C",
+		Synonyms: []string{"degree celsius", "degrees celsius"},
+		Category: category.Name,
+		Kind:     generator.ConversionKindAffine,
+		Ratio:    big.NewRat(1, 1),
+		Offset:   big.NewRat(27315, 100),
+	}, {
+		Title:    "Fahrenheit",
+		Name:     "fahrenheit",
+		Symbol:   "°F",
+		Synonyms: []string{"degree fahrenheit", "degrees fahrenheit"},
+		Category: category.Name,
+		Kind:     generator.ConversionKindAffine,
+		Ratio:    big.NewRat(5, 9),
+		Offset:   big.NewRat(45967, 180),
+	}}
+)
+
+func NewGenerator() *generator.UnitsGenerator {
+	return generator.NewUnitsGenerator(category, base, units)
+}
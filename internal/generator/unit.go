@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package generator
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/Masterminds/goutils"
+)
+
+// CatalogUnit is a linear unit as listed by a category generator.
+type CatalogUnit struct {
+	Full     string
+	Short    string
+	Symbol   string
+	Synonyms []string
+	Aliases  []ConversionAlias
+	Ratio    *big.Rat
+
+	CaseSensitive bool
+}
+
+// NewConversionUnit builds a linear ConversionUnit of category from its catalog entry.
+func NewConversionUnit(category UnitCategory, u CatalogUnit) ConversionUnit {
+	unit := ConversionUnit{
+		Title:    goutils.CapitalizeFully(u.Full),
+		Name:     u.Full,
+		Short:    strings.ToLower(u.Short),
+		Symbol:   u.Symbol,
+		Synonyms: u.Synonyms,
+		Category: category.Name,
+		Kind:     ConversionKindLinear,
+		Ratio:    u.Ratio,
+
+		CaseSensitive: u.CaseSensitive,
+	}
+	for _, alias := range u.Aliases {
+		unit.Aliases = append(unit.Aliases, ConversionAlias{
+			Title:      goutils.CapitalizeFully(alias.Name),
+			Name:       strings.ToLower(alias.Name),
+			Deprecated: alias.Deprecated,
+		})
+	}
+
+	return unit
+}
+
+// NewConversionUnits builds linear ConversionUnits of category from their catalog entries.
+func NewConversionUnits(category UnitCategory, units []CatalogUnit) []ConversionUnit {
+	var res []ConversionUnit
+	for _, unit := range units {
+		res = append(res, NewConversionUnit(category, unit))
+	}
+
+	return res
+}
+
+// Pow returns base raised to a non-negative exponent as an exact ratio.
+func Pow(base, exponent int64) *big.Rat {
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(base), big.NewInt(exponent), nil))
+}
+
+var _ Generator = &UnitsGenerator{}
+
+// guideExampleValue is converted from every unit in worked examples of guides.
+var guideExampleValue = big.NewRat(3, 2)
+
+// UnitsGenerator generates definitions of units of a category, which has no provider functions.
+type UnitsGenerator struct {
+	Base
+
+	Category UnitCategory
+	BaseUnit ConversionUnit
+	Units    []ConversionUnit
+}
+
+func NewUnitsGenerator(category UnitCategory, base ConversionUnit, units []ConversionUnit) *UnitsGenerator {
+	return &UnitsGenerator{
+		Base:     NewBase(),
+		Category: category,
+		BaseUnit: base,
+		Units:    units,
+	}
+}
+
+func (g *UnitsGenerator) GenerateUnitDefinitions() UnitCategory {
+	g.GenerateUnits(g.Category, g.BaseUnit, g.Units)
+
+	return g.Category
+}
+
+func (g *UnitsGenerator) GenerateGuide() {
+	g.WriteGuide(NewGuide(g.Category, g.BaseUnit, g.Units, guideExampleValue, false))
+}
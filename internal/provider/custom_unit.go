@@ -0,0 +1,137 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+// CustomUnitModel describes a unit defined in the provider configuration relative to an existing unit.
+type CustomUnitModel struct {
+	Name     types.String `tfsdk:"name"`
+	Symbol   types.String `tfsdk:"symbol"`
+	Category types.String `tfsdk:"category"`
+	Factor   types.Number `tfsdk:"factor"`
+	Unit     types.String `tfsdk:"unit"`
+}
+
+func customUnitBlock() schema.Block {
+	var categories []string
+	for _, category := range units.Default.Categories() {
+		categories = append(categories, category.Name)
+	}
+
+	return schema.ListNestedBlock{
+		Description:         "Unit defined as a factor of an existing unit, e.g. slots of 8 GiB. Data sources accept custom units of their category.",
+		MarkdownDescription: "Unit defined as a factor of an existing unit, e.g. slots of `8 GiB`. Data sources accept custom units of their category.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description:         "Name of the unit, e.g. slots.",
+					MarkdownDescription: "Name of the unit, e.g. `slots`.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"symbol": schema.StringAttribute{
+					Description:         "Symbol of the unit, e.g. slot.",
+					MarkdownDescription: "Symbol of the unit, e.g. `slot`.",
+					Optional:            true,
+				},
+				"category": schema.StringAttribute{
+					Description:         "Category of the unit, e.g. data_size.",
+					MarkdownDescription: "Category of the unit, e.g. `data_size`.",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(categories...),
+					},
+				},
+				"factor": schema.NumberAttribute{
+					Description:         "Positive number of units in one custom unit, e.g. 8.",
+					MarkdownDescription: "Positive number of units in one custom unit, e.g. `8`.",
+					Required:            true,
+				},
+				"unit": schema.StringAttribute{
+					Description: "Name, symbol or alias of the unit, which the factor is relative to, e.g. GiB. " +
+						"It is either a unit of the category or a custom unit defined earlier.",
+					MarkdownDescription: "Name, symbol or alias of the unit, which the factor is relative to, e.g. `GiB`. " +
+						"It is either a unit of the category or a custom unit defined earlier.",
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// customUnitsRegistry returns a registry of the default units along with customUnits.
+// It returns nil if there are no custom units.
+func customUnitsRegistry(customUnits []CustomUnitModel) (*units.Registry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(customUnits) == 0 {
+		return nil, diags
+	}
+
+	registry, err := units.NewRegistry(units.Default.Categories()...)
+	if err != nil {
+		diags.AddError("Cannot Create Unit Registry", fmt.Sprintf("%s. Please report this issue to the provider developers.", err))
+
+		return nil, diags
+	}
+
+	for i, customUnit := range customUnits {
+		unitPath := path.Root("custom_unit").AtListIndex(i)
+
+		if customUnit.Name.IsUnknown() || customUnit.Symbol.IsUnknown() || customUnit.Category.IsUnknown() ||
+			customUnit.Factor.IsUnknown() || customUnit.Unit.IsUnknown() {
+			diags.AddAttributeError(unitPath, "Unknown Custom Unit", "Custom units must be known during plan.")
+			continue
+		}
+
+		unit, err := registry.Lookup(customUnit.Unit.ValueString())
+		if err != nil {
+			diags.AddAttributeError(unitPath.AtName("unit"), "Invalid Custom Unit", fmt.Sprintf("Cannot define unit %q: %s.", customUnit.Name.ValueString(), err))
+			continue
+		}
+		if unit.Category != customUnit.Category.ValueString() {
+			diags.AddAttributeError(
+				unitPath.AtName("category"),
+				"Invalid Custom Unit",
+				fmt.Sprintf("Cannot define unit %q of category %s relative to unit %s of category %s.", customUnit.Name.ValueString(), customUnit.Category.ValueString(), unit.Name, unit.Category),
+			)
+			continue
+		}
+
+		// Factors are taken as written in decimal, so 0.1 is exactly one tenth.
+		factor, ok := units.Decimal(customUnit.Factor.ValueBigFloat())
+		if !ok {
+			diags.AddAttributeError(unitPath.AtName("factor"), "Invalid Custom Unit", fmt.Sprintf("Factor of unit %q must be a finite number.", customUnit.Name.ValueString()))
+			continue
+		}
+
+		derived, err := units.DeriveUnit(customUnit.Name.ValueString(), customUnit.Symbol.ValueString(), factor, unit)
+		if err != nil {
+			diags.AddAttributeError(unitPath.AtName("factor"), "Invalid Custom Unit", fmt.Sprintf("Cannot define unit %q: %s.", customUnit.Name.ValueString(), err))
+			continue
+		}
+
+		if err = registry.RegisterUnit(derived); err != nil {
+			diags.AddAttributeError(unitPath.AtName("name"), "Invalid Custom Unit", fmt.Sprintf("Cannot define unit %q: %s.", customUnit.Name.ValueString(), err))
+		}
+	}
+
+	return registry, diags
+}
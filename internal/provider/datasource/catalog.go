@@ -0,0 +1,257 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+var _ datasource.DataSource = &Catalog{}
+var _ datasource.DataSourceWithConfigure = &Catalog{}
+
+func NewCatalog() datasource.DataSource {
+	return &Catalog{}
+}
+
+// Catalog defines the data source implementation listing supported units.
+type Catalog struct {
+	providerData ProviderData
+}
+
+var catalogDescription = strings.Join([]string{
+	"Catalog of supported units.",
+	"This data source lists categories of units along with names, symbols, aliases and factors of their units,",
+	"including custom units of the provider configuration, e.g. to validate variables and show supported units in error messages.",
+}, " ")
+
+const catalogDescriptionMd =
+// language=markdown
+`
+## Catalog of supported units
+
+This data source lists categories of units along with names, symbols, aliases and factors of their units,
+including custom units of the provider configuration, e.g. to validate variables and show supported units in error messages.
+`
+
+// CatalogModel describes the data source data model.
+type CatalogModel struct {
+	Category types.String `tfsdk:"category"`
+
+	Categories []CatalogCategoryModel `tfsdk:"categories"`
+	Units      []CatalogUnitModel     `tfsdk:"units"`
+}
+
+// CatalogCategoryModel describes a category of units.
+type CatalogCategoryModel struct {
+	Name      types.String `tfsdk:"name"`
+	BaseUnit  types.String `tfsdk:"base_unit"`
+	Dimension types.String `tfsdk:"dimension"`
+	Units     types.List   `tfsdk:"units"`
+}
+
+// CatalogUnitModel describes a unit of a category.
+type CatalogUnitModel struct {
+	Name      types.String `tfsdk:"name"`
+	Symbol    types.String `tfsdk:"symbol"`
+	Aliases   types.List   `tfsdk:"aliases"`
+	Category  types.String `tfsdk:"category"`
+	Dimension types.String `tfsdk:"dimension"`
+	Factor    types.String `tfsdk:"factor"`
+}
+
+// List fills the catalog with categories of registry, or with the configured category only.
+func (m *CatalogModel) List(registry *units.Registry) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	categories := registry.Categories()
+	if !m.Category.IsNull() {
+		category, ok := registry.Category(m.Category.ValueString())
+		if !ok {
+			var names []string
+			for _, c := range categories {
+				names = append(names, c.Name)
+			}
+			diags.AddAttributeError(
+				path.Root("category"),
+				"Unknown Category",
+				fmt.Sprintf("Category %q is not found, expected one of: %s.", m.Category.ValueString(), strings.Join(names, ", ")),
+			)
+
+			return diags
+		}
+		categories = []units.Category{category}
+	}
+
+	m.Categories = []CatalogCategoryModel{}
+	m.Units = []CatalogUnitModel{}
+	for _, category := range categories {
+		var names []string
+		for _, unit := range category.Units {
+			names = append(names, unit.Name)
+			m.Units = append(m.Units, catalogUnit(category, unit))
+		}
+
+		m.Categories = append(m.Categories, CatalogCategoryModel{
+			Name:      types.StringValue(category.Name),
+			BaseUnit:  types.StringValue(category.Base.Name),
+			Dimension: types.StringValue(category.Dimension().String()),
+			Units:     stringList(names),
+		})
+	}
+
+	return diags
+}
+
+// catalogUnit describes unit of category. Factors are set for linear units only.
+func catalogUnit(category units.Category, unit units.Unit) CatalogUnitModel {
+	factor := types.StringNull()
+	if unit.Kind == units.KindLinear && category.Base.Kind == units.KindLinear {
+		factor = types.StringValue(converter.Exact(new(big.Rat).Quo(unit.Scale, category.Base.Scale)))
+	}
+
+	return CatalogUnitModel{
+		Name:      types.StringValue(unit.Name),
+		Symbol:    types.StringValue(unit.Symbol),
+		Aliases:   stringList(unit.Aliases),
+		Category:  types.StringValue(category.Name),
+		Dimension: types.StringValue(unit.Dimension.String()),
+		Factor:    factor,
+	}
+}
+
+func stringList(values []string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func (d *Catalog) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_catalog"
+}
+
+func (d *Catalog) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerData(req, resp); data != nil {
+		d.providerData = *data
+	}
+}
+
+func (d *Catalog) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         catalogDescription,
+		MarkdownDescription: catalogDescriptionMd,
+		Attributes: map[string]schema.Attribute{
+			"category": schema.StringAttribute{
+				Description:         "Name of the category to list, e.g. data_size. By default, all categories are listed.",
+				MarkdownDescription: "Name of the category to list, e.g. `data_size`. By default, all categories are listed.",
+				Optional:            true,
+			},
+			"categories": schema.ListNestedAttribute{
+				Description:         "Categories of units in order of registration.",
+				MarkdownDescription: "Categories of units in order of registration.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description:         "Name of the category, e.g. data_size.",
+							MarkdownDescription: "Name of the category, e.g. `data_size`.",
+							Computed:            true,
+						},
+						"base_unit": schema.StringAttribute{
+							Description:         "Name of the base unit of the category, e.g. bytes.",
+							MarkdownDescription: "Name of the base unit of the category, e.g. `bytes`.",
+							Computed:            true,
+						},
+						"dimension": schema.StringAttribute{
+							Description:         "Dimension of units of the category, e.g. information.",
+							MarkdownDescription: "Dimension of units of the category, e.g. `information`.",
+							Computed:            true,
+						},
+						"units": schema.ListAttribute{
+							Description:         "Names of units of the category.",
+							MarkdownDescription: "Names of units of the category.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"units": schema.ListNestedAttribute{
+				Description:         "Units of the listed categories.",
+				MarkdownDescription: "Units of the listed categories.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description:         "Name of the unit, e.g. gibibytes.",
+							MarkdownDescription: "Name of the unit, e.g. `gibibytes`.",
+							Computed:            true,
+						},
+						"symbol": schema.StringAttribute{
+							Description:         "Symbol of the unit, e.g. GiB.",
+							MarkdownDescription: "Symbol of the unit, e.g. `GiB`.",
+							Computed:            true,
+						},
+						"aliases": schema.ListAttribute{
+							Description:         "Alternative names of the unit, e.g. gibibyte and Gi.",
+							MarkdownDescription: "Alternative names of the unit, e.g. `gibibyte` and `Gi`.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"category": schema.StringAttribute{
+							Description:         "Name of the category of the unit.",
+							MarkdownDescription: "Name of the category of the unit.",
+							Computed:            true,
+						},
+						"dimension": schema.StringAttribute{
+							Description:         "Dimension of the unit, e.g. information.",
+							MarkdownDescription: "Dimension of the unit, e.g. `information`.",
+							Computed:            true,
+						},
+						"factor": schema.StringAttribute{
+							Description: "Exact number of base units in the unit as a decimal or a fraction, e.g. 1073741824 for gibibytes. " +
+								"Null for units, which are not proportional to the base unit, e.g. degrees Celsius.",
+							MarkdownDescription: "Exact number of base units in the unit as a decimal or a fraction, e.g. `1073741824` for gibibytes. " +
+								"Null for units, which are not proportional to the base unit, e.g. degrees Celsius.",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *Catalog) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CatalogModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(data.List(d.providerData.registry())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package datasource_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/dstaroff/terraform-provider-units/internal/testutils"
+)
+
+func TestAccCatalogDataSource(t *testing.T) {
+	const config =
+	// language=hcl-terraform
+	`
+	provider "units" {
+	  custom_unit {
+	    name     = "slots"
+	    category = "data_size"
+	    factor   = 8
+	    unit     = "GiB"
+	  }
+	}
+
+	data "units_catalog" "data_size" {
+	  category = "data_size"
+	}
+
+	data "units_catalog" "temperature" {
+	  category = "temperature"
+	}
+
+	data "units_catalog" "all" {}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.units_catalog.data_size", "categories.#", "1"),
+				resource.TestCheckResourceAttr("data.units_catalog.data_size", "categories.0.base_unit", "bytes"),
+				resource.TestCheckResourceAttr("data.units_catalog.data_size", "categories.0.dimension", "information"),
+				resource.TestCheckResourceAttr("data.units_catalog.data_size", "units.#", "12"),
+				resource.TestCheckResourceAttr("data.units_catalog.data_size", "units.3.name", "gibibytes"),
+				resource.TestCheckResourceAttr("data.units_catalog.data_size", "units.3.symbol", "GiB"),
+				resource.TestCheckResourceAttr("data.units_catalog.data_size", "units.3.aliases.0", "gibibyte"),
+				resource.TestCheckResourceAttr("data.units_catalog.data_size", "units.3.factor", "1073741824"),
+				resource.TestCheckResourceAttr("data.units_catalog.data_size", "units.11.name", "slots"),
+				resource.TestCheckResourceAttr("data.units_catalog.data_size", "units.11.factor", "8589934592"),
+				resource.TestCheckResourceAttr("data.units_catalog.temperature", "units.1.name", "celsius"),
+				resource.TestCheckNoResourceAttr("data.units_catalog.temperature", "units.1.factor"),
+				resource.TestCheckResourceAttr("data.units_catalog.all", "categories.0.name", "data_size"),
+			),
+		}},
+	})
+}
+
+func TestAccCatalogDataSource_UnknownCategory(t *testing.T) {
+	const config =
+	// language=hcl-terraform
+	`
+	data "units_catalog" "test" {
+	  category = "money"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config:      config,
+			ExpectError: regexp.MustCompile(`Category "money" is not found`),
+		}},
+	})
+}
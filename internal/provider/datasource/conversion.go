@@ -0,0 +1,246 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package datasource
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+// conversion converts values of a data source model, whose attributes hold values in units of a category,
+// and collects diagnostics of the results.
+type conversion struct {
+	category converter.Category
+	options  converter.Options
+	diags    diag.Diagnostics
+
+	// integers are names of units, in which results must be integers.
+	integers map[string]bool
+}
+
+// exactPrecision is the precision in bits of numbers parsed from quantity strings,
+// which is the precision of numbers in Terraform configurations.
+const exactPrecision = 512
+
+// exactNumber returns value parsed from a quantity string as a number.
+func exactNumber(value *big.Rat) types.Number {
+	return types.NumberValue(new(big.Float).SetPrec(exactPrecision).SetRat(value))
+}
+
+// attribute is a unit along with the path of the attribute holding a value in it.
+type attribute struct {
+	unit units.Unit
+	path path.Path
+}
+
+// unitAttribute returns the attribute named after a unit of the category of c.
+func (c *conversion) unitAttribute(name string) (attribute, bool) {
+	unit, err := c.options.UnitRegistry().Lookup(name)
+	if err != nil || unit.Category != c.category.Name {
+		c.diags.AddError(
+			"Unknown Unit",
+			fmt.Sprintf("Unit %q of %s is not found. Please report this issue to the provider developers.", name, c.category.Quantity),
+		)
+
+		return attribute{}, false
+	}
+
+	return attribute{unit: unit, path: path.Root(name)}, true
+}
+
+// customUnits returns units of the category of c, which are defined in the provider configuration.
+func (c *conversion) customUnits() []units.Unit {
+	category, ok := c.options.UnitRegistry().Category(c.category.Name)
+	if !ok {
+		return nil
+	}
+
+	var res []units.Unit
+	for _, unit := range category.Units {
+		if _, err := units.Default.Lookup(unit.Name); err != nil {
+			res = append(res, unit)
+		}
+	}
+
+	return res
+}
+
+// requireIntegers makes results in units listed in names integers. Errors are reported on the list at p.
+func (c *conversion) requireIntegers(p path.Path, names types.List) bool {
+	if names.IsNull() || names.IsUnknown() {
+		return true
+	}
+
+	c.integers = map[string]bool{}
+	for i, element := range names.Elements() {
+		name, ok := element.(types.String)
+		if !ok || name.IsUnknown() {
+			continue
+		}
+
+		unit, err := c.options.UnitRegistry().Lookup(name.ValueString())
+		if err == nil && unit.Category != c.category.Name {
+			err = fmt.Errorf("%w: %s is not a unit of %s", units.ErrIncompatibleUnits, unit.Name, c.category.Quantity)
+		}
+		if err != nil {
+			c.diags.AddAttributeError(p.AtListIndex(i), "Invalid Integer Unit", fmt.Sprintf("Cannot require integers in %q: %s.", name.ValueString(), err))
+
+			return false
+		}
+		c.integers[unit.Name] = true
+	}
+
+	return true
+}
+
+// convert converts number from one unit to another.
+// Errors are reported on the attribute of the input unit, and rounded results are reported on the attribute of the result.
+func (c *conversion) convert(from, to attribute, number types.Number) types.Number {
+	res, err := c.category.ConvertUnits(number, from.unit, to.unit, c.options.Precision)
+	if err != nil && !errors.Is(err, converter.ErrPrecisionLoss) {
+		c.diags.AddAttributeError(
+			from.path,
+			"Conversion Failed",
+			fmt.Sprintf("Cannot convert %s in %s to %s: %s.", c.category.Quantity, from.unit.Name, to.unit.Name, err),
+		)
+
+		return res
+	}
+
+	// The input value is kept as configured.
+	input := from.path.Equal(to.path)
+	if c.options.Rounding != nil && !input {
+		res = converter.Round(res, *c.options.Rounding)
+	}
+
+	if c.options.Strict || c.integers[to.unit.Name] {
+		c.check(from, to, number, res, input)
+	}
+	// Results are rounded to decimal places on purpose, and strict mode reports rounding as errors.
+	if err != nil && c.options.Rounding == nil && !c.options.Strict {
+		c.diags.AddAttributeWarning(
+			to.path,
+			"Conversion Result Is Rounded",
+			fmt.Sprintf("Converting %s in %s to %s, %s. Set the precision_bits provider argument to keep more digits.", c.category.Quantity, from.unit.Name, to.unit.Name, err),
+		)
+	}
+
+	return res
+}
+
+// check compares res with the exact conversion result of number, which is rounded to decimal places unless to is the input.
+// In strict mode, results differing from the exact value are errors, as well as fractional results in units requiring integers.
+func (c *conversion) check(from, to attribute, number, res types.Number, input bool) {
+	value, ok := units.Decimal(number.ValueBigFloat())
+	if !ok {
+		return
+	}
+	exact, err := units.Convert(value, from.unit, to.unit)
+	if err != nil {
+		return
+	}
+	if c.options.Rounding != nil && !input {
+		exact = c.options.Rounding.Round(exact)
+	}
+
+	if c.options.Strict && converter.Rounded(res.ValueBigFloat(), exact) {
+		c.diags.AddAttributeError(
+			to.path,
+			"Conversion Result Is Rounded",
+			fmt.Sprintf(
+				"Converting %s in %s to %s, the exact result %s is rounded to %s. Set the precision_bits provider argument to keep more digits, or disable strict mode.",
+				c.category.Quantity, from.unit.Name, to.unit.Name, converter.Exact(exact), res.ValueBigFloat().Text('g', -1),
+			),
+		)
+	}
+	if c.integers[to.unit.Name] && !exact.IsInt() {
+		c.diags.AddAttributeError(
+			to.path,
+			"Conversion Result Is Not an Integer",
+			fmt.Sprintf("Converting %s in %s to %s, the result must be an integer, got: %s.", c.category.Quantity, from.unit.Name, to.unit.Name, converter.Exact(exact)),
+		)
+	}
+}
+
+// quantity returns number in the unit of from as an exact quantity.
+func (c *conversion) quantity(from attribute, number types.Number) (units.Quantity, bool) {
+	value, ok := units.Decimal(number.ValueBigFloat())
+	if !ok {
+		c.diags.AddAttributeError(from.path, "Formatting Failed", fmt.Sprintf("Cannot format %s in %s: %s.", c.category.Quantity, from.unit.Name, converter.ErrOverflow))
+
+		return units.Quantity{}, false
+	}
+
+	return units.NewQuantity(value, from.unit), true
+}
+
+// format writes q in unit rounded with the formatting options of c, e.g. "1.61 GB".
+func (c *conversion) format(from attribute, q units.Quantity, unit units.Unit) types.String {
+	res, err := q.In(unit)
+	if err != nil {
+		c.diags.AddAttributeError(from.path, "Formatting Failed", fmt.Sprintf("Cannot format %s in %s: %s.", c.category.Quantity, unit.Name, err))
+
+		return types.StringUnknown()
+	}
+
+	return types.StringValue(res.Round(c.options.Formatting).String())
+}
+
+// humanize writes q in the largest unit of system, in which it is at least 1, rounded with the formatting options of c, e.g. "1.5 GiB".
+// A system without units is the unit system of c.
+func (c *conversion) humanize(from attribute, q units.Quantity, system units.UnitSystem) types.String {
+	if len(system.Units) == 0 {
+		system = c.options.UnitSystem
+	}
+	if len(system.Units) == 0 {
+		system = units.IEC
+	}
+
+	res, err := system.Humanize(q)
+	if err != nil {
+		c.diags.AddAttributeError(from.path, "Formatting Failed", fmt.Sprintf("Cannot format %s in %s: %s.", c.category.Quantity, from.unit.Name, err))
+
+		return types.StringUnknown()
+	}
+
+	return types.StringValue(res.Round(c.options.Formatting).String())
+}
+
+// whole writes q in the unit of to as an integer rounded with mode, e.g. floor.
+// Values out of the int64 range are errors reported on the attribute of to.
+func (c *conversion) whole(q units.Quantity, to attribute, mode units.RoundingMode) types.Int64 {
+	res, err := q.In(to.unit)
+	if err != nil {
+		c.diags.AddAttributeError(to.path, "Conversion Failed", fmt.Sprintf("Cannot convert %s in %s to %s: %s.", c.category.Quantity, q.Unit.Name, to.unit.Name, err))
+
+		return types.Int64Unknown()
+	}
+
+	value := units.Rounding{Mode: mode}.Round(res.Value).Num()
+	if !value.IsInt64() {
+		c.diags.AddAttributeError(
+			to.path,
+			"Conversion Result Is Out of Range",
+			fmt.Sprintf(
+				"Converting %s in %s to %s, the whole result %s is out of the int64 range from %d to %d.",
+				c.category.Quantity, q.Unit.Name, to.unit.Name, value, int64(math.MinInt64), int64(math.MaxInt64),
+			),
+		)
+
+		return types.Int64Unknown()
+	}
+
+	return types.Int64Value(value.Int64())
+}
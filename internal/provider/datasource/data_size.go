@@ -12,23 +12,33 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/dstaroff/terraform-provider-units/internal/converter"
+	"github.com/dstaroff/terraform-provider-units/internal/provider/settings"
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
 )
 
 var _ datasource.DataSource = &DataSize{}
+var _ datasource.DataSourceWithConfigure = &DataSize{}
 
 func NewDataSize() datasource.DataSource {
 	return &DataSize{}
 }
 
 // DataSize defines the data source implementation for data size conversion.
-type DataSize struct{}
+type DataSize struct {
+	providerData ProviderData
+}
 
 var dataSizeDescription = strings.Join([]string{
 	"Container for data sizes.",
@@ -67,57 +77,218 @@ type DataSizeModel struct {
 	Gigabytes types.Number `tfsdk:"gigabytes"`
 	Terabytes types.Number `tfsdk:"terabytes"`
 	Petabytes types.Number `tfsdk:"petabytes"`
+
+	Value        types.String `tfsdk:"value"`
+	Custom       types.Map    `tfsdk:"custom"`
+	CustomValues types.Map    `tfsdk:"custom_values"`
+
+	Human     types.String `tfsdk:"human"`
+	HumanIEC  types.String `tfsdk:"human_iec"`
+	HumanSI   types.String `tfsdk:"human_si"`
+	Formatted types.Map    `tfsdk:"formatted"`
+
+	WholeDirection types.String `tfsdk:"whole_direction"`
+	BytesInt64     types.Int64  `tfsdk:"bytes_int64"`
+	Whole          types.Map    `tfsdk:"whole"`
+
+	RequireIntegerUnits types.List `tfsdk:"require_integer_units"`
+
+	settings.Model
+}
+
+// attributes returns pointers to values of the model by names of their units.
+func (m *DataSizeModel) attributes() map[string]*types.Number {
+	return map[string]*types.Number{
+		"bytes": &m.Bytes,
+
+		"kibibytes": &m.Kibibytes,
+		"mebibytes": &m.Mebibytes,
+		"gibibytes": &m.Gibibytes,
+		"tebibytes": &m.Tebibytes,
+		"pebibytes": &m.Pebibytes,
+
+		"kilobytes": &m.Kilobytes,
+		"megabytes": &m.Megabytes,
+		"gigabytes": &m.Gigabytes,
+		"terabytes": &m.Terabytes,
+		"petabytes": &m.Petabytes,
+	}
 }
 
 // Convert performs the conversion of data size.
-func (m *DataSizeModel) Convert() {
-	bytes := types.NumberValue(big.NewFloat(0))
-	if !m.Bytes.IsNull() {
-		bytes = m.Bytes
-	} else if !m.Kibibytes.IsNull() {
-		bytes = converter.KibibytesToBytes(m.Kibibytes)
-	} else if !m.Mebibytes.IsNull() {
-		bytes = converter.MebibytesToBytes(m.Mebibytes)
-	} else if !m.Gibibytes.IsNull() {
-		bytes = converter.GibibytesToBytes(m.Gibibytes)
-	} else if !m.Tebibytes.IsNull() {
-		bytes = converter.TebibytesToBytes(m.Tebibytes)
-	} else if !m.Pebibytes.IsNull() {
-		bytes = converter.PebibytesToBytes(m.Pebibytes)
-	} else if !m.Kilobytes.IsNull() {
-		bytes = converter.KilobytesToBytes(m.Kilobytes)
-	} else if !m.Megabytes.IsNull() {
-		bytes = converter.MegabytesToBytes(m.Megabytes)
-	} else if !m.Gigabytes.IsNull() {
-		bytes = converter.GigabytesToBytes(m.Gigabytes)
-	} else if !m.Terabytes.IsNull() {
-		bytes = converter.TerabytesToBytes(m.Terabytes)
-	} else if !m.Petabytes.IsNull() {
-		bytes = converter.PetabytesToBytes(m.Petabytes)
-	}
-
-	m.Bytes = bytes
-
-	m.Kibibytes = converter.KibibytesFromBytes(bytes)
-	m.Mebibytes = converter.MebibytesFromBytes(bytes)
-	m.Gibibytes = converter.GibibytesFromBytes(bytes)
-	m.Tebibytes = converter.TebibytesFromBytes(bytes)
-	m.Pebibytes = converter.PebibytesFromBytes(bytes)
-
-	m.Kilobytes = converter.KilobytesFromBytes(bytes)
-	m.Megabytes = converter.MegabytesFromBytes(bytes)
-	m.Gigabytes = converter.GigabytesFromBytes(bytes)
-	m.Terabytes = converter.TerabytesFromBytes(bytes)
-	m.Petabytes = converter.PetabytesFromBytes(bytes)
+func (m *DataSizeModel) Convert(options converter.Options) diag.Diagnostics {
+	c := conversion{category: converter.DataSizeCategory, options: options}
+	attributes := m.attributes()
+	names := converter.UnitNames(c.category.Name)
+	m.CustomValues = types.MapValueMust(types.NumberType, map[string]attr.Value{})
+	m.Human = types.StringUnknown()
+	m.HumanIEC = types.StringUnknown()
+	m.HumanSI = types.StringUnknown()
+	m.Formatted = types.MapUnknown(types.StringType)
+	m.BytesInt64 = types.Int64Unknown()
+	m.Whole = types.MapUnknown(types.Int64Type)
+
+	if !c.requireIntegers(path.Root("require_integer_units"), m.RequireIntegerUnits) {
+		return c.diags
+	}
+
+	input, ok := c.unitAttribute(c.category.Base)
+	if !ok {
+		return c.diags
+	}
+	value := types.NumberValue(big.NewFloat(0))
+	for _, name := range names {
+		if attribute := attributes[name]; attribute != nil && !attribute.IsNull() {
+			if input, ok = c.unitAttribute(name); !ok {
+				return c.diags
+			}
+			value = *attribute
+			break
+		}
+	}
+	if !m.Custom.IsNull() {
+		if input, value, ok = m.customInput(&c); !ok {
+			return c.diags
+		}
+	}
+	if !m.Value.IsNull() {
+		if input, value, ok = m.valueInput(&c); !ok {
+			return c.diags
+		}
+	}
+
+	for _, name := range names {
+		attribute, ok := attributes[name]
+		if !ok {
+			c.diags.AddError("Missing Data Size Attribute", fmt.Sprintf("Unit %q has no attribute. Please report this issue to the provider developers.", name))
+			continue
+		}
+
+		output, ok := c.unitAttribute(name)
+		if !ok {
+			return c.diags
+		}
+		*attribute = c.convert(input, output, value)
+		if c.diags.HasError() {
+			return c.diags
+		}
+	}
+
+	customValues := map[string]attr.Value{}
+	for _, unit := range c.customUnits() {
+		customValues[unit.Name] = c.convert(input, attribute{unit: unit, path: path.Root("custom_values").AtMapKey(unit.Name)}, value)
+		if c.diags.HasError() {
+			return c.diags
+		}
+	}
+	m.CustomValues = types.MapValueMust(types.NumberType, customValues)
+
+	q, ok := c.quantity(input, value)
+	if !ok {
+		return c.diags
+	}
+	m.Human = c.humanize(input, q, units.UnitSystem{})
+	m.HumanIEC = c.humanize(input, q, units.IEC)
+	m.HumanSI = c.humanize(input, q, units.SI)
+
+	direction := units.RoundFloor
+	if !m.WholeDirection.IsNull() {
+		direction = units.RoundingMode(m.WholeDirection.ValueString())
+	}
+	bytes, ok := c.unitAttribute(c.category.Base)
+	if !ok {
+		return c.diags
+	}
+	m.BytesInt64 = c.whole(q, attribute{unit: bytes.unit, path: path.Root("bytes_int64")}, direction)
+
+	formatted := map[string]attr.Value{}
+	whole := map[string]attr.Value{}
+	for _, name := range names {
+		output, ok := c.unitAttribute(name)
+		if !ok {
+			return c.diags
+		}
+		formatted[name] = c.format(input, q, output.unit)
+		whole[name] = c.whole(q, attribute{unit: output.unit, path: path.Root("whole").AtMapKey(name)}, direction)
+	}
+	for _, unit := range c.customUnits() {
+		formatted[unit.Name] = c.format(input, q, unit)
+		whole[unit.Name] = c.whole(q, attribute{unit: unit, path: path.Root("whole").AtMapKey(unit.Name)}, direction)
+	}
+	m.Formatted = types.MapValueMust(types.StringType, formatted)
+	if c.diags.HasError() {
+		return c.diags
+	}
+	m.Whole = types.MapValueMust(types.Int64Type, whole)
+
+	return c.diags
+}
+
+// customInput returns the custom unit and the value of the custom attribute, which must have exactly one element.
+func (m *DataSizeModel) customInput(c *conversion) (attribute, types.Number, bool) {
+	custom := path.Root("custom")
+
+	elements := m.Custom.Elements()
+	if len(elements) != 1 {
+		c.diags.AddAttributeError(custom, "Invalid Custom Data Size", fmt.Sprintf("Expected exactly one value in a custom unit, got: %d.", len(elements)))
+
+		return attribute{}, types.Number{}, false
+	}
+
+	for name, element := range elements {
+		unit, err := c.options.UnitRegistry().Lookup(name)
+		if err == nil && unit.Category != c.category.Name {
+			err = fmt.Errorf("%w: %s is not a unit of %s", units.ErrIncompatibleUnits, unit.Name, c.category.Quantity)
+		}
+		if err != nil {
+			c.diags.AddAttributeError(custom.AtMapKey(name), "Invalid Custom Data Size", fmt.Sprintf("Cannot convert %s in %q: %s.", c.category.Quantity, name, err))
+
+			return attribute{}, types.Number{}, false
+		}
+
+		number, ok := element.(types.Number)
+		if !ok {
+			c.diags.AddAttributeError(custom.AtMapKey(name), "Invalid Custom Data Size", fmt.Sprintf("Expected a number, got: %T. Please report this issue to the provider developers.", element))
+
+			return attribute{}, types.Number{}, false
+		}
+
+		return attribute{unit: unit, path: custom.AtMapKey(name)}, number, true
+	}
+
+	return attribute{}, types.Number{}, false
+}
+
+// valueInput returns the unit and the value of the value attribute, which is a data size string, e.g. "20GiB".
+func (m *DataSizeModel) valueInput(c *conversion) (attribute, types.Number, bool) {
+	p := path.Root("value")
+
+	q, err := c.options.UnitRegistry().ParseQuantity(m.Value.ValueString())
+	if err == nil && !units.Compatible(q.Unit, units.Bytes) {
+		err = fmt.Errorf("%w: %s is not a unit of %s", units.ErrIncompatibleUnits, q.Unit.Name, c.category.Quantity)
+	}
+	if err != nil {
+		c.diags.AddAttributeError(p, "Invalid Data Size", fmt.Sprintf("Cannot parse %s: %s.", c.category.Quantity, err))
+
+		return attribute{}, types.Number{}, false
+	}
+
+	return attribute{unit: q.Unit, path: p}, exactNumber(q.Value), true
 }
 
 func (d *DataSize) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_data_size"
 }
 
+func (d *DataSize) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerData(req, resp); data != nil {
+		d.providerData = *data
+	}
+}
+
 func (d *DataSize) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{}
-	for _, dataSizeName := range converter.DataSizeNames {
+	for _, dataSizeName := range converter.UnitNames(converter.DataSizeCategory.Name) {
 		description := fmt.Sprintf("Data size in %s.", dataSizeName)
 		attributes[dataSizeName] = schema.NumberAttribute{
 			Description:         description,
@@ -126,6 +297,83 @@ func (d *DataSize) Schema(_ context.Context, _ datasource.SchemaRequest, resp *d
 			Computed:            true,
 		}
 	}
+	attributes["value"] = schema.StringAttribute{
+		Description: "Data size followed by a unit symbol or name, e.g. 20GiB, 1.5 TB or 2 slots. " +
+			"Custom units of the provider configuration are accepted.",
+		MarkdownDescription: "Data size followed by a unit symbol or name, e.g. `20GiB`, `1.5 TB` or `2 slots`. " +
+			"Custom units of the provider configuration are accepted.",
+		Optional: true,
+	}
+	attributes["custom"] = schema.MapAttribute{
+		Description: "Data size in a custom unit defined by a custom_unit block of the provider configuration, e.g. { slots = 2 }. " +
+			"Exactly one element is allowed.",
+		MarkdownDescription: "Data size in a custom unit defined by a `custom_unit` block of the provider configuration, e.g. `{ slots = 2 }`. " +
+			"Exactly one element is allowed.",
+		ElementType: types.NumberType,
+		Optional:    true,
+		Validators: []validator.Map{
+			mapvalidator.SizeBetween(1, 1),
+		},
+	}
+	attributes["custom_values"] = schema.MapAttribute{
+		Description:         "Data size in every custom unit of data size defined in the provider configuration by unit names.",
+		MarkdownDescription: "Data size in every custom unit of data size defined in the provider configuration by unit names.",
+		ElementType:         types.NumberType,
+		Computed:            true,
+	}
+	attributes["human"] = schema.StringAttribute{
+		Description:         "Data size in the largest unit of unit_system, in which it is at least 1, e.g. 1.5 GiB.",
+		MarkdownDescription: "Data size in the largest unit of `unit_system`, in which it is at least `1`, e.g. `1.5 GiB`.",
+		Computed:            true,
+	}
+	attributes["require_integer_units"] = schema.ListAttribute{
+		Description: "Names, symbols or aliases of units, e.g. [\"GB\"], in which results must be integers. " +
+			"Fractional results in these units are errors naming the attribute and the exact value.",
+		MarkdownDescription: "Names, symbols or aliases of units, e.g. `[\"GB\"]`, in which results must be integers. " +
+			"Fractional results in these units are errors naming the attribute and the exact value.",
+		ElementType: types.StringType,
+		Optional:    true,
+	}
+	attributes["human_iec"] = schema.StringAttribute{
+		Description:         "Data size in the largest IEC unit, in which it is at least 1, e.g. 1.5 GiB.",
+		MarkdownDescription: "Data size in the largest IEC unit, in which it is at least `1`, e.g. `1.5 GiB`.",
+		Computed:            true,
+	}
+	attributes["human_si"] = schema.StringAttribute{
+		Description:         "Data size in the largest SI unit, in which it is at least 1, e.g. 1.61 GB.",
+		MarkdownDescription: "Data size in the largest SI unit, in which it is at least `1`, e.g. `1.61 GB`.",
+		Computed:            true,
+	}
+	attributes["formatted"] = schema.MapAttribute{
+		Description:         "Data size in every unit, including custom units of the provider configuration, formatted with unit symbols by unit names, e.g. { gibibytes = \"1.5 GiB\" }.",
+		MarkdownDescription: "Data size in every unit, including custom units of the provider configuration, formatted with unit symbols by unit names, e.g. `{ gibibytes = \"1.5 GiB\" }`.",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+	attributes["whole_direction"] = schema.StringAttribute{
+		Description:         "Direction of rounding data sizes to whole numbers in bytes_int64 and whole: floor or ceiling. Defaults to floor.",
+		MarkdownDescription: "Direction of rounding data sizes to whole numbers in `bytes_int64` and `whole`: `floor` or `ceiling`. Defaults to `floor`.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(string(units.RoundFloor), string(units.RoundCeiling)),
+		},
+	}
+	attributes["bytes_int64"] = schema.Int64Attribute{
+		Description:         "Data size in bytes rounded to a whole number in whole_direction. Data sizes out of the int64 range are errors.",
+		MarkdownDescription: "Data size in bytes rounded to a whole number in `whole_direction`. Data sizes out of the `int64` range are errors.",
+		Computed:            true,
+	}
+	attributes["whole"] = schema.MapAttribute{
+		Description: "Data size in every unit, including custom units of the provider configuration, rounded to whole numbers in whole_direction by unit names. " +
+			"Data sizes out of the int64 range are errors.",
+		MarkdownDescription: "Data size in every unit, including custom units of the provider configuration, rounded to whole numbers in `whole_direction` by unit names. " +
+			"Data sizes out of the `int64` range are errors.",
+		ElementType: types.Int64Type,
+		Computed:    true,
+	}
+	for name, attribute := range settings.DataSourceAttributes() {
+		attributes[name] = attribute
+	}
 
 	resp.Schema = schema.Schema{
 		Description:         dataSizeDescription,
@@ -143,16 +391,17 @@ func (d *DataSize) Read(ctx context.Context, req datasource.ReadRequest, resp *d
 	}
 
 	tflog.Trace(ctx, "converting data size")
-	data.Convert()
+	resp.Diagnostics.Append(data.Convert(d.providerData.options(data.Model))...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (d *DataSize) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
 	var expressions []path.Expression
-	for _, dataSizeName := range converter.DataSizeNames {
+	for _, dataSizeName := range converter.UnitNames(converter.DataSizeCategory.Name) {
 		expressions = append(expressions, path.MatchRoot(dataSizeName))
 	}
+	expressions = append(expressions, path.MatchRoot("value"), path.MatchRoot("custom"))
 
 	return []datasource.ConfigValidator{
 		datasourcevalidator.ExactlyOneOf(
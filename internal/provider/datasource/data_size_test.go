@@ -6,6 +6,7 @@
 package datasource_test
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -162,3 +163,451 @@ func TestAccDataSizeDataSource_NoAttributesProvided(t *testing.T) {
 		}},
 	})
 }
+
+func TestAccDataSizeDataSource_Precision(t *testing.T) {
+	const config =
+	// language=hcl-terraform
+	`
+	provider "units" {
+	  precision_bits = 512
+	}
+
+	data "units_data_size" "test" {
+	  bytes = 1000000000000000
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.units_data_size.test", "gibibytes", "931322.574615478515625"),
+				resource.TestCheckResourceAttr("data.units_data_size.test", "pebibytes", "0.88817841970012523233890533447265625"),
+			),
+		}},
+	})
+}
+
+func TestAccDataSizeDataSource_InvalidPrecision(t *testing.T) {
+	const config =
+	// language=hcl-terraform
+	`
+	provider "units" {
+	  precision_bits = 0
+	}
+
+	data "units_data_size" "test" {
+	  bytes = 0
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config:      config,
+			ExpectError: regexp.MustCompile(`Invalid Attribute Value`),
+		}},
+	})
+}
+
+func TestAccDataSizeDataSource_CustomUnits(t *testing.T) {
+	const config =
+	// language=hcl-terraform
+	`
+	provider "units" {
+	  custom_unit {
+	    name     = "slots"
+	    symbol   = "slot"
+	    category = "data_size"
+	    factor   = 8
+	    unit     = "GiB"
+	  }
+
+	  custom_unit {
+	    name     = "blocks"
+	    category = "data_size"
+	    factor   = 4
+	    unit     = "MiB"
+	  }
+	}
+
+	data "units_data_size" "test" {
+	  custom = {
+	    slots = 2
+	  }
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.units_data_size.test", "gibibytes", "16"),
+				resource.TestCheckResourceAttr("data.units_data_size.test", "custom_values.slots", "2"),
+				resource.TestCheckResourceAttr("data.units_data_size.test", "custom_values.blocks", "4096"),
+			),
+		}},
+	})
+}
+
+func TestAccDataSizeDataSource_InvalidCustomUnit(t *testing.T) {
+	for _, tc := range []struct {
+		config string
+		error  *regexp.Regexp
+	}{{
+		// language=hcl-terraform
+		config: `
+		provider "units" {
+		  custom_unit {
+		    name     = "slots"
+		    category = "duration"
+		    factor   = 8
+		    unit     = "GiB"
+		  }
+		}
+
+		data "units_data_size" "test" {
+		  bytes = 1
+		}
+		`,
+		error: regexp.MustCompile(`relative to unit gibibytes of category data_size`),
+	}, {
+		// language=hcl-terraform
+		config: `
+		data "units_data_size" "test" {
+		  custom = {
+		    slots = 1
+		  }
+		}
+		`,
+		error: regexp.MustCompile(`unknown unit "slots"`),
+	}} {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{{
+				Config:      tc.config,
+				ExpectError: tc.error,
+			}},
+		})
+	}
+}
+
+func TestAccDataSizeDataSource_Rounding(t *testing.T) {
+	const config =
+	// language=hcl-terraform
+	`
+	provider "units" {
+	  decimal_places = 2
+	}
+
+	data "units_data_size" "inherited" {
+	  megabytes = 1610.6127
+	}
+
+	data "units_data_size" "overridden" {
+	  megabytes      = 1610.6127
+	  decimal_places = 0
+	  rounding_mode  = "ceiling"
+	  unit_system    = "si"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.units_data_size.inherited", "megabytes", "1610.6127"),
+				resource.TestCheckResourceAttr("data.units_data_size.inherited", "gibibytes", "1.5"),
+				resource.TestCheckResourceAttr("data.units_data_size.inherited", "gigabytes", "1.61"),
+				resource.TestCheckResourceAttr("data.units_data_size.inherited", "human", "1.5 GiB"),
+				resource.TestCheckResourceAttr("data.units_data_size.overridden", "gibibytes", "2"),
+				resource.TestCheckResourceAttr("data.units_data_size.overridden", "gigabytes", "2"),
+				resource.TestCheckResourceAttr("data.units_data_size.overridden", "human", "1.62 GB"),
+			),
+		}},
+	})
+}
+
+func TestAccDataSizeDataSource_InvalidSettings(t *testing.T) {
+	for _, config := range []string{
+		// language=hcl-terraform
+		`
+		data "units_data_size" "test" {
+		  bytes         = 1
+		  rounding_mode = "nearest"
+		}
+		`,
+
+		// language=hcl-terraform
+		`
+		provider "units" {
+		  unit_system = "binary"
+		}
+
+		data "units_data_size" "test" {
+		  bytes = 1
+		}
+		`,
+	} {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			}},
+		})
+	}
+}
+
+func TestAccDataSizeDataSource_Strict(t *testing.T) {
+	for _, tc := range []struct {
+		config string
+		error  *regexp.Regexp
+	}{{
+		// language=hcl-terraform
+		config: `
+		provider "units" {
+		  strict = true
+		}
+
+		data "units_data_size" "test" {
+		  megabytes = 1610.6127
+		}
+		`,
+		error: regexp.MustCompile(`the exact result 1535.999965667724609375 is rounded`),
+	}, {
+		// language=hcl-terraform
+		config: `
+		data "units_data_size" "test" {
+		  gibibytes             = 1.5
+		  require_integer_units = ["GB"]
+		}
+		`,
+		error: regexp.MustCompile(`the result must be an integer, got: 1.610612736`),
+	}, {
+		// language=hcl-terraform
+		config: `
+		data "units_data_size" "test" {
+		  gibibytes             = 1.5
+		  require_integer_units = ["s"]
+		}
+		`,
+		error: regexp.MustCompile(`seconds is not a unit of data size`),
+	}} {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{{
+				Config:      tc.config,
+				ExpectError: tc.error,
+			}},
+		})
+	}
+}
+
+func TestAccDataSizeDataSource_StrictRounded(t *testing.T) {
+	const config =
+	// language=hcl-terraform
+	`
+	provider "units" {
+	  strict = true
+	}
+
+	data "units_data_size" "test" {
+	  gibibytes             = 1.5
+	  decimal_places        = 0
+	  rounding_mode         = "ceiling"
+	  require_integer_units = ["GB"]
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.units_data_size.test", "gibibytes", "1.5"),
+				resource.TestCheckResourceAttr("data.units_data_size.test", "gigabytes", "2"),
+			),
+		}},
+	})
+}
+
+func TestAccDataSizeDataSource_DecimalInput(t *testing.T) {
+	// Strict mode turns warnings about rounded results into errors, so these configurations convert without warnings.
+	for _, tc := range []struct {
+		config string
+		checks map[string]string
+	}{{
+		// language=hcl-terraform
+		config: `
+		provider "units" {
+		  precision_bits = 512
+		  strict         = true
+		}
+
+		data "units_data_size" "test" {
+		  gigabytes = 0.1
+		}
+		`,
+		checks: map[string]string{
+			"bytes":     "100000000",
+			"megabytes": "100",
+			"gibibytes": "0.0931322574615478515625",
+		},
+	}, {
+		// language=hcl-terraform
+		config: `
+		provider "units" {
+		  precision_bits = 512
+		  strict         = true
+		}
+
+		data "units_data_size" "test" {
+		  gibibytes = 931.32
+		}
+		`,
+		checks: map[string]string{
+			"bytes":     "999997235527.68",
+			"mebibytes": "953671.68",
+			"gigabytes": "999.99723552768",
+		},
+	}} {
+		var checks []resource.TestCheckFunc
+		for attribute, value := range tc.checks {
+			checks = append(checks, resource.TestCheckResourceAttr("data.units_data_size.test", attribute, value))
+		}
+
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{{
+				Config: tc.config,
+				Check:  resource.ComposeAggregateTestCheckFunc(checks...),
+			}},
+		})
+	}
+}
+
+func TestAccDataSizeDataSource_Value(t *testing.T) {
+	for _, value := range []string{"20GiB", "20 gibibytes", "20480 Mi", "160 Gibit"} {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{{
+				Config: fmt.Sprintf(`
+				data "units_data_size" "test" {
+				  value = %q
+				}
+				`, value),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.units_data_size.test", "value", value),
+					resource.TestCheckResourceAttr("data.units_data_size.test", "bytes", "21474836480"),
+					resource.TestCheckResourceAttr("data.units_data_size.test", "gibibytes", "20"),
+					resource.TestCheckResourceAttr("data.units_data_size.test", "gigabytes", "21.47483648"),
+				),
+			}},
+		})
+	}
+}
+
+func TestAccDataSizeDataSource_InvalidValue(t *testing.T) {
+	for _, tc := range []struct {
+		value string
+		error *regexp.Regexp
+	}{
+		{value: "20 GiBB", error: regexp.MustCompile(`did you mean "GiB"`)},
+		{value: "5 s", error: regexp.MustCompile(`seconds is not a unit of data size`)},
+		{value: "GiB", error: regexp.MustCompile(`expected a number`)},
+	} {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{{
+				Config: fmt.Sprintf(`
+				data "units_data_size" "test" {
+				  value = %q
+				}
+				`, tc.value),
+				ExpectError: tc.error,
+			}},
+		})
+	}
+}
+
+func TestAccDataSizeDataSource_Formatted(t *testing.T) {
+	const config =
+	// language=hcl-terraform
+	`
+	data "units_data_size" "default" {
+	  gibibytes = 1.5
+	}
+
+	data "units_data_size" "precise" {
+	  gibibytes             = 1.5
+	  format_decimal_places = 4
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.units_data_size.default", "human_iec", "1.5 GiB"),
+				resource.TestCheckResourceAttr("data.units_data_size.default", "human_si", "1.61 GB"),
+				resource.TestCheckResourceAttr("data.units_data_size.default", "formatted.gibibytes", "1.5 GiB"),
+				resource.TestCheckResourceAttr("data.units_data_size.default", "formatted.megabytes", "1610.61 MB"),
+				resource.TestCheckResourceAttr("data.units_data_size.default", "formatted.tebibytes", "0 TiB"),
+				resource.TestCheckResourceAttr("data.units_data_size.precise", "human_si", "1.6106 GB"),
+				resource.TestCheckResourceAttr("data.units_data_size.precise", "formatted.tebibytes", "0.0015 TiB"),
+			),
+		}},
+	})
+}
+
+func TestAccDataSizeDataSource_Whole(t *testing.T) {
+	const config =
+	// language=hcl-terraform
+	`
+	data "units_data_size" "floor" {
+	  gibibytes = 1.5
+	}
+
+	data "units_data_size" "ceiling" {
+	  gibibytes       = 1.5
+	  whole_direction = "ceiling"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.units_data_size.floor", "bytes_int64", "1610612736"),
+				resource.TestCheckResourceAttr("data.units_data_size.floor", "whole.gibibytes", "1"),
+				resource.TestCheckResourceAttr("data.units_data_size.floor", "whole.megabytes", "1610"),
+				resource.TestCheckResourceAttr("data.units_data_size.ceiling", "whole.gibibytes", "2"),
+				resource.TestCheckResourceAttr("data.units_data_size.ceiling", "whole.megabytes", "1611"),
+			),
+		}},
+	})
+}
+
+func TestAccDataSizeDataSource_WholeOverflow(t *testing.T) {
+	const config =
+	// language=hcl-terraform
+	`
+	data "units_data_size" "test" {
+	  pebibytes = 1000000
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config:      config,
+			ExpectError: regexp.MustCompile(`the whole result 1125899906842624000000 is out of the int64 range`),
+		}},
+	})
+}
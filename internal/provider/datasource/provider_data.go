@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package datasource
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+	"github.com/dstaroff/terraform-provider-units/internal/provider/settings"
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+// ProviderData is the provider configuration shared with data sources.
+type ProviderData struct {
+	// Settings are conversion settings, which data sources override.
+	Settings settings.Model
+	// Strict turns rounded conversion results into errors.
+	Strict bool
+	// Registry holds units of the default registry along with custom units of the provider configuration.
+	// Nil means units.Default.
+	Registry *units.Registry
+}
+
+// options returns conversion options of the provider configuration overridden by settings of a data source.
+func (d ProviderData) options(overrides settings.Model) converter.Options {
+	options := d.Settings.Override(overrides).Options(d.Registry)
+	options.Strict = d.Strict
+
+	return options
+}
+
+// registry returns units of the provider configuration.
+func (d ProviderData) registry() *units.Registry {
+	if d.Registry == nil {
+		return units.Default
+	}
+
+	return d.Registry
+}
+
+// providerData extracts ProviderData passed by the provider to a data source.
+// It returns nil if the provider is not configured yet.
+func providerData(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *ProviderData {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	data, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *datasource.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return nil
+	}
+
+	return data
+}
@@ -0,0 +1,267 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+	"github.com/dstaroff/terraform-provider-units/internal/provider/settings"
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+var _ datasource.DataSource = &Quantity{}
+var _ datasource.DataSourceWithConfigure = &Quantity{}
+var _ datasource.DataSourceWithConfigValidators = &Quantity{}
+
+func NewQuantity() datasource.DataSource {
+	return &Quantity{}
+}
+
+// Quantity defines the data source implementation for conversion of quantities of any category.
+type Quantity struct {
+	providerData ProviderData
+}
+
+var quantityDescription = strings.Join([]string{
+	"Container for quantities of any category.",
+	"This data source is capable of taking a value in a unit (e.g. 1.5 and GiB) or a quantity string (e.g. 1.5GiB)",
+	"and convert it to every unit of its category, which is inferred from the unit.",
+	"NOTE: Specify either value and unit, or quantity.",
+}, " ")
+
+const quantityDescriptionMd =
+// language=markdown
+`
+## Container for quantities of any category
+
+This data source is capable of taking a value in a unit (e.g. ` + "`1.5`" + ` and ` + "`GiB`" + `) or a quantity string (e.g. ` + "`1.5GiB`" + `)
+and convert it to every unit of its category, which is inferred from the unit.
+
+Units are names, symbols, aliases or expressions of them, e.g. ` + "`GiB*s/s`" + `, which reduces to a unit of ` + "`data_size`" + `.
+Expressions without a category, e.g. ` + "`MiB/s`" + `, are converted to the product of base units of their factors, e.g. ` + "`B/s`" + `.
+
+**NOTE**:
+Specify either ` + "`value`" + ` and ` + "`unit`" + `, or ` + "`quantity`" + `.
+`
+
+var _ converter.Converter = &QuantityModel{}
+
+// QuantityModel describes the data source data model.
+type QuantityModel struct {
+	Value    types.Number `tfsdk:"value"`
+	Unit     types.String `tfsdk:"unit"`
+	Quantity types.String `tfsdk:"quantity"`
+
+	Category  types.String `tfsdk:"category"`
+	BaseUnit  types.String `tfsdk:"base_unit"`
+	BaseValue types.Number `tfsdk:"base_value"`
+	Values    types.Map    `tfsdk:"values"`
+
+	RequireIntegerUnits types.List `tfsdk:"require_integer_units"`
+
+	settings.Model
+}
+
+// Convert performs the conversion of the quantity to every unit of its category.
+func (m *QuantityModel) Convert(options converter.Options) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.Category = types.StringUnknown()
+	m.BaseUnit = types.StringUnknown()
+	m.BaseValue = types.NumberUnknown()
+	m.Values = types.MapUnknown(types.NumberType)
+
+	input, value, ok := m.input(options, &diags)
+	if !ok {
+		return diags
+	}
+
+	category, ok := options.UnitRegistry().UnitCategory(input.unit)
+	if !ok {
+		// Expressions without a category are converted to the product of base units of their factors, e.g. B/s.
+		base, err := options.UnitRegistry().BaseUnit(input.unit)
+		if err != nil {
+			diags.AddAttributeError(input.path, "Unknown Category", fmt.Sprintf("No category of units of %s is found for %s: %s.", input.unit.Dimension, input.unit.Name, err))
+
+			return diags
+		}
+
+		category = units.Category{Base: base, Units: []units.Unit{base}}
+		if input.unit.Name != base.Name {
+			category.Units = append(category.Units, input.unit)
+		}
+	}
+
+	c := conversion{category: converter.CategoryOf(category), options: options, diags: diags}
+	if category.Name == "" {
+		c.category.Quantity = input.unit.Dimension.String()
+	}
+	if !c.requireIntegers(path.Root("require_integer_units"), m.RequireIntegerUnits) {
+		return c.diags
+	}
+
+	baseValue := c.convert(input, attribute{unit: category.Base, path: path.Root("base_value")}, value)
+	if c.diags.HasError() {
+		return c.diags
+	}
+
+	values := map[string]attr.Value{}
+	for _, unit := range category.Units {
+		values[unit.Name] = c.convert(input, attribute{unit: unit, path: path.Root("values").AtMapKey(unit.Name)}, value)
+		if c.diags.HasError() {
+			return c.diags
+		}
+	}
+
+	m.Category = types.StringNull()
+	if category.Name != "" {
+		m.Category = types.StringValue(category.Name)
+	}
+	m.BaseUnit = types.StringValue(category.Base.Name)
+	m.BaseValue = baseValue
+	m.Values = types.MapValueMust(types.NumberType, values)
+
+	return c.diags
+}
+
+// input returns the unit and the value of the quantity configured either by value and unit, or by a quantity string.
+// The value and the unit are set from the quantity string, which is parsed exactly.
+func (m *QuantityModel) input(options converter.Options, diags *diag.Diagnostics) (attribute, types.Number, bool) {
+	if !m.Quantity.IsNull() {
+		q, err := options.UnitRegistry().ParseQuantity(m.Quantity.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("quantity"), "Invalid Quantity", fmt.Sprintf("Cannot parse quantity: %s.", err))
+
+			return attribute{}, types.Number{}, false
+		}
+
+		m.Value = exactNumber(q.Value)
+		m.Unit = types.StringValue(q.Unit.Name)
+
+		return attribute{unit: q.Unit, path: path.Root("quantity")}, m.Value, true
+	}
+
+	unit, err := options.UnitRegistry().Parse(m.Unit.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("unit"), "Invalid Unit", fmt.Sprintf("Cannot parse unit: %s.", err))
+
+		return attribute{}, types.Number{}, false
+	}
+
+	return attribute{unit: unit, path: path.Root("value")}, m.Value, true
+}
+
+func (d *Quantity) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quantity"
+}
+
+func (d *Quantity) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerData(req, resp); data != nil {
+		d.providerData = *data
+	}
+}
+
+func (d *Quantity) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"value": schema.NumberAttribute{
+			Description:         "Value of the quantity in unit. Set from quantity, if it is specified.",
+			MarkdownDescription: "Value of the quantity in `unit`. Set from `quantity`, if it is specified.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"unit": schema.StringAttribute{
+			Description:         "Name, symbol, alias or expression of the unit of value, e.g. GiB or MiB/s. Set to the unit name from quantity, if it is specified.",
+			MarkdownDescription: "Name, symbol, alias or expression of the unit of `value`, e.g. `GiB` or `MiB/s`. Set to the unit name from `quantity`, if it is specified.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"quantity": schema.StringAttribute{
+			Description:         "Value followed by a unit, e.g. 1.5GiB or 90 min.",
+			MarkdownDescription: "Value followed by a unit, e.g. `1.5GiB` or `90 min`.",
+			Optional:            true,
+		},
+		"category": schema.StringAttribute{
+			Description:         "Category of the unit, e.g. data_size. Null for expressions without a category, e.g. MiB/s.",
+			MarkdownDescription: "Category of the unit, e.g. `data_size`. Null for expressions without a category, e.g. `MiB/s`.",
+			Computed:            true,
+		},
+		"base_unit": schema.StringAttribute{
+			Description:         "Name of the base unit of the category, e.g. bytes, or the product of base units of an expression without a category, e.g. B/s.",
+			MarkdownDescription: "Name of the base unit of the category, e.g. `bytes`, or the product of base units of an expression without a category, e.g. `B/s`.",
+			Computed:            true,
+		},
+		"base_value": schema.NumberAttribute{
+			Description:         "Quantity in the base unit of the category.",
+			MarkdownDescription: "Quantity in the base unit of the category.",
+			Computed:            true,
+		},
+		"values": schema.MapAttribute{
+			Description:         "Quantity in every unit of the category, including custom units of the provider configuration, by unit names. Expressions without a category have values in the base unit and in the unit itself.",
+			MarkdownDescription: "Quantity in every unit of the category, including custom units of the provider configuration, by unit names. Expressions without a category have values in the base unit and in the unit itself.",
+			ElementType:         types.NumberType,
+			Computed:            true,
+		},
+		"require_integer_units": schema.ListAttribute{
+			Description: "Names, symbols or aliases of units, e.g. [\"GB\"], in which results must be integers. " +
+				"Fractional results in these units are errors naming the attribute and the exact value.",
+			MarkdownDescription: "Names, symbols or aliases of units, e.g. `[\"GB\"]`, in which results must be integers. " +
+				"Fractional results in these units are errors naming the attribute and the exact value.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+	}
+	for name, attribute := range settings.DataSourceAttributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         quantityDescription,
+		MarkdownDescription: quantityDescriptionMd,
+		Attributes:          attributes,
+	}
+}
+
+func (d *Quantity) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data QuantityModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "converting quantity")
+	resp.Diagnostics.Append(data.Convert(d.providerData.options(data.Model))...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *Quantity) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("value"),
+			path.MatchRoot("quantity"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("value"),
+			path.MatchRoot("unit"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("quantity"),
+			path.MatchRoot("unit"),
+		),
+	}
+}
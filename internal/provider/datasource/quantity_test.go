@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package datasource_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/dstaroff/terraform-provider-units/internal/testutils"
+)
+
+func TestAccQuantityDataSource(t *testing.T) {
+	for _, config := range []string{
+		// language=hcl-terraform
+		`
+		data "units_quantity" "test" {
+		  quantity = "1.5GiB"
+		}
+		`,
+
+		// language=hcl-terraform
+		`
+		data "units_quantity" "test" {
+		  value = 1.5
+		  unit  = "gibibytes"
+		}
+		`,
+	} {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.units_quantity.test", "value", "1.5"),
+					resource.TestCheckResourceAttr("data.units_quantity.test", "unit", "gibibytes"),
+					resource.TestCheckResourceAttr("data.units_quantity.test", "category", "data_size"),
+					resource.TestCheckResourceAttr("data.units_quantity.test", "base_unit", "bytes"),
+					resource.TestCheckResourceAttr("data.units_quantity.test", "base_value", "1610612736"),
+					resource.TestCheckResourceAttr("data.units_quantity.test", "values.mebibytes", "1536"),
+					resource.TestCheckResourceAttr("data.units_quantity.test", "values.gigabytes", "1.610612736"),
+				),
+			}},
+		})
+	}
+}
+
+func TestAccQuantityDataSource_Categories(t *testing.T) {
+	const config =
+	// language=hcl-terraform
+	`
+	data "units_quantity" "temperature" {
+	  value = 100
+	  unit  = "degC"
+	}
+
+	data "units_quantity" "duration" {
+	  quantity = "90 min"
+	}
+
+	data "units_quantity" "compound" {
+	  value = 3
+	  unit  = "GiB*s/s"
+	}
+
+	data "units_quantity" "power" {
+	  quantity = "30 dBm"
+	}
+
+	data "units_quantity" "rate" {
+	  quantity = "100 MiB/s"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: config,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.units_quantity.temperature", "category", "temperature"),
+				resource.TestCheckResourceAttr("data.units_quantity.temperature", "values.fahrenheit", "212"),
+				resource.TestCheckResourceAttr("data.units_quantity.duration", "category", "duration"),
+				resource.TestCheckResourceAttr("data.units_quantity.duration", "base_value", "5400"),
+				resource.TestCheckResourceAttr("data.units_quantity.duration", "values.hours", "1.5"),
+				resource.TestCheckResourceAttr("data.units_quantity.compound", "category", "data_size"),
+				resource.TestCheckResourceAttr("data.units_quantity.compound", "values.mebibytes", "3072"),
+				resource.TestCheckResourceAttr("data.units_quantity.power", "category", "power"),
+				resource.TestCheckResourceAttr("data.units_quantity.power", "base_value", "1"),
+				resource.TestCheckResourceAttr("data.units_quantity.power", "values.decibel-watts", "0"),
+				resource.TestCheckNoResourceAttr("data.units_quantity.rate", "category"),
+				resource.TestCheckResourceAttr("data.units_quantity.rate", "base_unit", "B/s"),
+				resource.TestCheckResourceAttr("data.units_quantity.rate", "base_value", "104857600"),
+				resource.TestCheckResourceAttr("data.units_quantity.rate", "values.%", "2"),
+				resource.TestCheckResourceAttr("data.units_quantity.rate", "values.MiB/s", "100"),
+			),
+		}},
+	})
+}
+
+func TestAccQuantityDataSource_Invalid(t *testing.T) {
+	for _, tc := range []struct {
+		config string
+		error  *regexp.Regexp
+	}{{
+		// language=hcl-terraform
+		config: `
+		data "units_quantity" "test" {
+		  quantity = "1.5 GiBB"
+		}
+		`,
+		error: regexp.MustCompile(`did you mean "GiB"`),
+	}, {
+		// language=hcl-terraform
+		config: `
+		data "units_quantity" "test" {
+		  quantity = "0 W"
+		}
+		`,
+		error: regexp.MustCompile(`logarithm of 0`),
+	}, {
+		// language=hcl-terraform
+		config: `
+		data "units_quantity" "test" {
+		  value    = 3
+		  quantity = "3 GiB"
+		}
+		`,
+		error: regexp.MustCompile(`Invalid Attribute Combination`),
+	}} {
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{{
+				Config:      tc.config,
+				ExpectError: tc.error,
+			}},
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+)
+
+var _ function.Function = &Conversion{}
+
+// Conversion is a provider-defined function converting a number from one unit to another.
+// Every conversion function of the provider is an instance of it built from the catalog.
+type Conversion struct {
+	Name                string
+	Summary             string
+	Description         string
+	MarkdownDescription string
+	DeprecationMessage  string
+
+	ParameterName                string
+	ParameterDescription         string
+	ParameterMarkdownDescription string
+
+	Convert func(number types.Number, precision uint) (types.Number, error)
+}
+
+func (f *Conversion) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.Name
+}
+
+func (f *Conversion) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             f.Summary,
+		Description:         f.Description,
+		MarkdownDescription: f.MarkdownDescription,
+
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:                f.ParameterName,
+				Description:         f.ParameterDescription,
+				MarkdownDescription: f.ParameterMarkdownDescription,
+			},
+		},
+		VariadicParameter: function.Int64Parameter{
+			Name: "precision_bits",
+			Description: fmt.Sprintf(
+				"Optional precision of the result in bits from 1 to %d. "+
+					"By default, the result has the precision of the argument, but at least 53 bits", converter.MaxPrecision,
+			),
+			MarkdownDescription: fmt.Sprintf(
+				"Optional precision of the result in bits from `1` to `%d`. "+
+					"By default, the result has the precision of the argument, but at least `53` bits", converter.MaxPrecision,
+			),
+		},
+		Return:             function.NumberReturn{},
+		DeprecationMessage: f.DeprecationMessage,
+	}
+}
+
+func (f *Conversion) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var number types.Number
+	var precisions []int64
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &number, &precisions))
+	if resp.Error != nil {
+		return
+	}
+
+	var precision uint
+	switch len(precisions) {
+	case 0:
+	case 1:
+		if precisions[0] < 1 || precisions[0] > int64(converter.MaxPrecision) {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Precision must be from 1 to %d bits, got: %d", converter.MaxPrecision, precisions[0]))
+			return
+		}
+		precision = uint(precisions[0])
+	default:
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("At most one precision can be given, got: %d", len(precisions)))
+		return
+	}
+
+	res, err := f.Convert(number, precision)
+	// Functions have no warnings, so a rounded result is returned as is.
+	if err != nil && !errors.Is(err, converter.ErrPrecisionLoss) {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Cannot convert %s: %s", f.ParameterName, err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, res))
+}
@@ -78,6 +78,68 @@ func TestAccDataSizeFunctions(t *testing.T) {
 	}
 }
 
+func TestAccDataSizeFunctions_aliases(t *testing.T) {
+	type testCaseType struct {
+		config string
+		result string
+	}
+	var testCases []testCaseType
+
+	for _, base := range []int{1000, 1024} {
+		unitSuffix := "bytes"
+		unitPrefixes := []string{"kilo", "mega", "giga", "tera", "peta"}
+		if base == 1024 {
+			unitPrefixes = []string{"kibi", "mebi", "gibi", "tebi", "pebi"}
+		}
+
+		for i, unitPrefix := range unitPrefixes {
+			result := base
+			for j := 1; j <= i; j++ {
+				result *= base
+			}
+
+			testCases = append(testCases, testCaseType{
+				config: fmt.Sprintf(
+					// language=hcl-terraform
+					`
+				output "test" {
+					value = provider::units::from_%s%s(1)
+				}
+				`, unitPrefix, unitSuffix,
+				),
+				result: strconv.Itoa(result),
+			}, testCaseType{
+				config: fmt.Sprintf(
+					// language=hcl-terraform
+					`
+				output "test" {
+					value = provider::units::to_%s%s(%d)
+				}
+				`, unitPrefix, unitSuffix, result,
+				),
+				result: strconv.Itoa(1),
+			})
+		}
+	}
+
+	for _, tc := range testCases {
+		resource.UnitTest(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: tc.config,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("test", tc.result),
+					),
+				},
+			},
+		})
+	}
+}
+
 func TestAccDataSizeFunctions_0(t *testing.T) {
 	type testCaseType struct {
 		config string
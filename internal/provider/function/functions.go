@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package function
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+)
+
+// GeneratedFunctions builds functions converting from and to the base unit for every unit in the catalog.
+func GeneratedFunctions() []func() function.Function {
+	return CategoryFunctions(converter.Catalog...)
+}
+
+// CategoryFunctions builds functions converting from and to the base unit for every unit of categories.
+// Functions named after deprecated aliases are deprecated in favour of functions named after short names of units.
+func CategoryFunctions(categories ...converter.Category) []func() function.Function {
+	var res []func() function.Function
+
+	for _, category := range categories {
+		for _, unit := range category.Units {
+			for _, c := range []struct {
+				direction  string
+				conversion Conversion
+			}{{
+				direction:  "from",
+				conversion: newConversion(category, unit.Name, category.Base, unit.ToBase),
+			}, {
+				direction:  "to",
+				conversion: newConversion(category, category.Base, unit.Name, unit.FromBase),
+			}} {
+				name := fmt.Sprintf("%s_%s", c.direction, unit.Short)
+				res = append(res, newFunction(c.conversion, name, ""))
+
+				for _, alias := range unit.Aliases {
+					var deprecationMessage string
+					if alias.Deprecated {
+						deprecationMessage = fmt.Sprintf("Use %s function instead.", name)
+					}
+
+					res = append(res, newFunction(c.conversion, fmt.Sprintf("%s_%s", c.direction, alias.Name), deprecationMessage))
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+func newConversion(category converter.Category, unitFrom, unitTo string, convert func(types.Number, uint) (types.Number, error)) Conversion {
+	quantity := strings.ToUpper(category.Quantity[:1]) + category.Quantity[1:]
+
+	return Conversion{
+		Summary:             fmt.Sprintf("Converts %s to %s", unitFrom, unitTo),
+		Description:         fmt.Sprintf("Given %s in %s, converts it to %s.", category.Quantity, unitFrom, unitTo),
+		MarkdownDescription: fmt.Sprintf("Given %s in **%s**, converts it to **%s**.", category.Quantity, unitFrom, unitTo),
+
+		ParameterName:                unitFrom,
+		ParameterDescription:         fmt.Sprintf("%s in %s", quantity, unitFrom),
+		ParameterMarkdownDescription: fmt.Sprintf("%s in **%s**", quantity, unitFrom),
+
+		Convert: convert,
+	}
+}
+
+func newFunction(conversion Conversion, name, deprecationMessage string) func() function.Function {
+	conversion.Name = name
+	conversion.DeprecationMessage = deprecationMessage
+
+	return func() function.Function {
+		f := conversion
+		return &f
+	}
+}
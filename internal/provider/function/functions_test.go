@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+	myfuncs "github.com/dstaroff/terraform-provider-units/internal/provider/function"
+)
+
+func TestCategoryFunctions_DeprecatedAlias(t *testing.T) {
+	category := converter.Category{
+		Name:     "data_size",
+		Quantity: "data size",
+		Base:     "bytes",
+		Units: []converter.Unit{{
+			Name:     "kibibytes",
+			Short:    "kib",
+			Aliases:  []converter.Alias{{Name: "kibibytes", Deprecated: true}, {Name: "kibi"}},
+			FromBase: converter.KibibytesFromBytes,
+			ToBase:   converter.KibibytesToBytes,
+		}},
+	}
+
+	messages := map[string]string{}
+	for _, f := range myfuncs.CategoryFunctions(category) {
+		fn := f()
+
+		metadata := &function.MetadataResponse{}
+		fn.Metadata(context.Background(), function.MetadataRequest{}, metadata)
+		definition := &function.DefinitionResponse{}
+		fn.Definition(context.Background(), function.DefinitionRequest{}, definition)
+
+		messages[metadata.Name] = definition.Definition.DeprecationMessage
+	}
+
+	for name, expected := range map[string]string{
+		"from_kib":       "",
+		"to_kib":         "",
+		"from_kibibytes": "Use from_kib function instead.",
+		"to_kibibytes":   "Use to_kib function instead.",
+		"from_kibi":      "",
+		"to_kibi":        "",
+	} {
+		message, ok := messages[name]
+		if !ok {
+			t.Errorf("function %s is not built", name)
+			continue
+		}
+		if message != expected {
+			t.Errorf("%s: expected deprecation message %q, got %q", name, expected, message)
+		}
+	}
+	if len(messages) != 6 {
+		t.Errorf("expected 6 functions, got %v", messages)
+	}
+}
@@ -1,89 +0,0 @@
-// Code generated by go generate; DO NOT EDIT.
-/*
- * Copyright (c) 2024. Dmitry Starov
- * SPDX-License-Identifier: MPL-2.0
- */
-
-package generated
-
-import (
-	"context"
-
-	"github.com/hashicorp/terraform-plugin-framework/function"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/dstaroff/terraform-provider-units/internal/converter"
-)
-
-var (
-	_ function.Function = &FromKibibytesModel{}
-	_ function.Function = &ToKibibytesModel{}
-)
-
-func NewFromKibibytesModel() function.Function {
-	return &FromKibibytesModel{}
-}
-
-type FromKibibytesModel struct{}
-
-func (f *FromKibibytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "from_kib"
-}
-
-func (f *FromKibibytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts kibibytes to bytes",
-		Description:         "Given data size in kibibytes, converts it to bytes.",
-		MarkdownDescription: "Given data size in **kibibytes**, converts it to **bytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "kibibytes",
-				Description:         "Data size in kibibytes",
-				MarkdownDescription: "Data size in **kibibytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *FromKibibytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var kibibytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &kibibytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.KibibytesToBytes(kibibytes)))
-}
-
-func NewToKibibytesModel() function.Function {
-	return &ToKibibytesModel{}
-}
-
-type ToKibibytesModel struct{}
-
-func (f *ToKibibytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "to_kib"
-}
-
-func (f *ToKibibytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts bytes to kibibytes",
-		Description:         "Given data size in bytes, converts it to kibibytes.",
-		MarkdownDescription: "Given data size in **bytes**, converts it to **kibibytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "bytes",
-				Description:         "Data size in bytes",
-				MarkdownDescription: "Data size in **bytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *ToKibibytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var bytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &bytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.KibibytesFromBytes(bytes)))
-}
@@ -1,89 +0,0 @@
-// Code generated by go generate; DO NOT EDIT.
-/*
- * Copyright (c) 2024. Dmitry Starov
- * SPDX-License-Identifier: MPL-2.0
- */
-
-package generated
-
-import (
-	"context"
-
-	"github.com/hashicorp/terraform-plugin-framework/function"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/dstaroff/terraform-provider-units/internal/converter"
-)
-
-var (
-	_ function.Function = &FromKilobytesModel{}
-	_ function.Function = &ToKilobytesModel{}
-)
-
-func NewFromKilobytesModel() function.Function {
-	return &FromKilobytesModel{}
-}
-
-type FromKilobytesModel struct{}
-
-func (f *FromKilobytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "from_kb"
-}
-
-func (f *FromKilobytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts kilobytes to bytes",
-		Description:         "Given data size in kilobytes, converts it to bytes.",
-		MarkdownDescription: "Given data size in **kilobytes**, converts it to **bytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "kilobytes",
-				Description:         "Data size in kilobytes",
-				MarkdownDescription: "Data size in **kilobytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *FromKilobytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var kilobytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &kilobytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.KilobytesToBytes(kilobytes)))
-}
-
-func NewToKilobytesModel() function.Function {
-	return &ToKilobytesModel{}
-}
-
-type ToKilobytesModel struct{}
-
-func (f *ToKilobytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "to_kb"
-}
-
-func (f *ToKilobytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts bytes to kilobytes",
-		Description:         "Given data size in bytes, converts it to kilobytes.",
-		MarkdownDescription: "Given data size in **bytes**, converts it to **kilobytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "bytes",
-				Description:         "Data size in bytes",
-				MarkdownDescription: "Data size in **bytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *ToKilobytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var bytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &bytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.KilobytesFromBytes(bytes)))
-}
@@ -1,89 +0,0 @@
-// Code generated by go generate; DO NOT EDIT.
-/*
- * Copyright (c) 2024. Dmitry Starov
- * SPDX-License-Identifier: MPL-2.0
- */
-
-package generated
-
-import (
-	"context"
-
-	"github.com/hashicorp/terraform-plugin-framework/function"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/dstaroff/terraform-provider-units/internal/converter"
-)
-
-var (
-	_ function.Function = &FromMebibytesModel{}
-	_ function.Function = &ToMebibytesModel{}
-)
-
-func NewFromMebibytesModel() function.Function {
-	return &FromMebibytesModel{}
-}
-
-type FromMebibytesModel struct{}
-
-func (f *FromMebibytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "from_mib"
-}
-
-func (f *FromMebibytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts mebibytes to bytes",
-		Description:         "Given data size in mebibytes, converts it to bytes.",
-		MarkdownDescription: "Given data size in **mebibytes**, converts it to **bytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "mebibytes",
-				Description:         "Data size in mebibytes",
-				MarkdownDescription: "Data size in **mebibytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *FromMebibytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var mebibytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &mebibytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.MebibytesToBytes(mebibytes)))
-}
-
-func NewToMebibytesModel() function.Function {
-	return &ToMebibytesModel{}
-}
-
-type ToMebibytesModel struct{}
-
-func (f *ToMebibytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "to_mib"
-}
-
-func (f *ToMebibytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts bytes to mebibytes",
-		Description:         "Given data size in bytes, converts it to mebibytes.",
-		MarkdownDescription: "Given data size in **bytes**, converts it to **mebibytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "bytes",
-				Description:         "Data size in bytes",
-				MarkdownDescription: "Data size in **bytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *ToMebibytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var bytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &bytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.MebibytesFromBytes(bytes)))
-}
@@ -1,89 +0,0 @@
-// Code generated by go generate; DO NOT EDIT.
-/*
- * Copyright (c) 2024. Dmitry Starov
- * SPDX-License-Identifier: MPL-2.0
- */
-
-package generated
-
-import (
-	"context"
-
-	"github.com/hashicorp/terraform-plugin-framework/function"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/dstaroff/terraform-provider-units/internal/converter"
-)
-
-var (
-	_ function.Function = &FromPetabytesModel{}
-	_ function.Function = &ToPetabytesModel{}
-)
-
-func NewFromPetabytesModel() function.Function {
-	return &FromPetabytesModel{}
-}
-
-type FromPetabytesModel struct{}
-
-func (f *FromPetabytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "from_pb"
-}
-
-func (f *FromPetabytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts petabytes to bytes",
-		Description:         "Given data size in petabytes, converts it to bytes.",
-		MarkdownDescription: "Given data size in **petabytes**, converts it to **bytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "petabytes",
-				Description:         "Data size in petabytes",
-				MarkdownDescription: "Data size in **petabytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *FromPetabytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var petabytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &petabytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.PetabytesToBytes(petabytes)))
-}
-
-func NewToPetabytesModel() function.Function {
-	return &ToPetabytesModel{}
-}
-
-type ToPetabytesModel struct{}
-
-func (f *ToPetabytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "to_pb"
-}
-
-func (f *ToPetabytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts bytes to petabytes",
-		Description:         "Given data size in bytes, converts it to petabytes.",
-		MarkdownDescription: "Given data size in **bytes**, converts it to **petabytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "bytes",
-				Description:         "Data size in bytes",
-				MarkdownDescription: "Data size in **bytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *ToPetabytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var bytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &bytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.PetabytesFromBytes(bytes)))
-}
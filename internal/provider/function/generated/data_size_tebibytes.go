@@ -1,89 +0,0 @@
-// Code generated by go generate; DO NOT EDIT.
-/*
- * Copyright (c) 2024. Dmitry Starov
- * SPDX-License-Identifier: MPL-2.0
- */
-
-package generated
-
-import (
-	"context"
-
-	"github.com/hashicorp/terraform-plugin-framework/function"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/dstaroff/terraform-provider-units/internal/converter"
-)
-
-var (
-	_ function.Function = &FromTebibytesModel{}
-	_ function.Function = &ToTebibytesModel{}
-)
-
-func NewFromTebibytesModel() function.Function {
-	return &FromTebibytesModel{}
-}
-
-type FromTebibytesModel struct{}
-
-func (f *FromTebibytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "from_tib"
-}
-
-func (f *FromTebibytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts tebibytes to bytes",
-		Description:         "Given data size in tebibytes, converts it to bytes.",
-		MarkdownDescription: "Given data size in **tebibytes**, converts it to **bytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "tebibytes",
-				Description:         "Data size in tebibytes",
-				MarkdownDescription: "Data size in **tebibytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *FromTebibytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var tebibytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &tebibytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.TebibytesToBytes(tebibytes)))
-}
-
-func NewToTebibytesModel() function.Function {
-	return &ToTebibytesModel{}
-}
-
-type ToTebibytesModel struct{}
-
-func (f *ToTebibytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "to_tib"
-}
-
-func (f *ToTebibytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts bytes to tebibytes",
-		Description:         "Given data size in bytes, converts it to tebibytes.",
-		MarkdownDescription: "Given data size in **bytes**, converts it to **tebibytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "bytes",
-				Description:         "Data size in bytes",
-				MarkdownDescription: "Data size in **bytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *ToTebibytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var bytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &bytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.TebibytesFromBytes(bytes)))
-}
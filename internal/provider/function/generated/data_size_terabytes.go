@@ -1,89 +0,0 @@
-// Code generated by go generate; DO NOT EDIT.
-/*
- * Copyright (c) 2024. Dmitry Starov
- * SPDX-License-Identifier: MPL-2.0
- */
-
-package generated
-
-import (
-	"context"
-
-	"github.com/hashicorp/terraform-plugin-framework/function"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/dstaroff/terraform-provider-units/internal/converter"
-)
-
-var (
-	_ function.Function = &FromTerabytesModel{}
-	_ function.Function = &ToTerabytesModel{}
-)
-
-func NewFromTerabytesModel() function.Function {
-	return &FromTerabytesModel{}
-}
-
-type FromTerabytesModel struct{}
-
-func (f *FromTerabytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "from_tb"
-}
-
-func (f *FromTerabytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts terabytes to bytes",
-		Description:         "Given data size in terabytes, converts it to bytes.",
-		MarkdownDescription: "Given data size in **terabytes**, converts it to **bytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "terabytes",
-				Description:         "Data size in terabytes",
-				MarkdownDescription: "Data size in **terabytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *FromTerabytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var terabytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &terabytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.TerabytesToBytes(terabytes)))
-}
-
-func NewToTerabytesModel() function.Function {
-	return &ToTerabytesModel{}
-}
-
-type ToTerabytesModel struct{}
-
-func (f *ToTerabytesModel) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
-	resp.Name = "to_tb"
-}
-
-func (f *ToTerabytesModel) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
-	resp.Definition = function.Definition{
-		Summary:             "Converts bytes to terabytes",
-		Description:         "Given data size in bytes, converts it to terabytes.",
-		MarkdownDescription: "Given data size in **bytes**, converts it to **terabytes**.",
-
-		Parameters: []function.Parameter{
-			function.NumberParameter{
-				Name:                "bytes",
-				Description:         "Data size in bytes",
-				MarkdownDescription: "Data size in **bytes**",
-			},
-		},
-		Return: function.NumberReturn{},
-	}
-}
-
-func (f *ToTerabytesModel) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
-	var bytes types.Number
-
-	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &bytes))
-	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, converter.TerabytesFromBytes(bytes)))
-}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+var _ function.Function = &NormalizeUnit{}
+
+// NormalizeUnit is a provider-defined function returning the canonical symbol of a unit expression.
+type NormalizeUnit struct{}
+
+func NewNormalizeUnit() function.Function {
+	return &NormalizeUnit{}
+}
+
+func (f *NormalizeUnit) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_unit"
+}
+
+func (f *NormalizeUnit) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalizes a unit expression",
+		Description:         "Given a unit expression, e.g. kg*m/s^2 or MiB/s, returns its canonical symbol.",
+		MarkdownDescription: "Given a unit expression, e.g. `kg*m/s^2` or `MiB/s`, returns its canonical symbol.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "unit",
+				Description: "Unit expression. Units are multiplied with * or ·, divided with /, raised to a power with ^ or superscripts and grouped with parentheses. Expressions longer than 256 characters are errors",
+				MarkdownDescription: "Unit expression. Units are multiplied with `*` or `·`, divided with `/`, " +
+					"raised to a power with `^` or superscripts and grouped with parentheses. Expressions longer than 256 characters are errors",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeUnit) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &expression))
+	if resp.Error != nil {
+		return
+	}
+
+	unit, err := units.Default.Parse(expression)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, unit.Symbol))
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/dstaroff/terraform-provider-units/internal/testutils"
+)
+
+func TestAccNormalizeUnitFunction(t *testing.T) {
+	for expression, symbol := range map[string]string{
+		"MiB/s":              "MiB/s",
+		"kilobytes / second": "kB/s",
+		"kg*m/s²":            "kg·m/s^2",
+		"GB*month":           "GB·mo",
+		"degC":               "°C",
+	} {
+		resource.UnitTest(t, resource.TestCase{
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_8_0),
+			},
+			ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(
+						// language=hcl-terraform
+						`
+						output "test" {
+							value = provider::units::normalize_unit(%q)
+						}
+						`, expression,
+					),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckOutput("test", symbol),
+					),
+				},
+			},
+		})
+	}
+}
+
+func TestAccNormalizeUnitFunction_invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::units::normalize_unit("GiB/secnd")
+				}
+				`,
+				ExpectError: regexp.MustCompile(`unknown unit "secnd", did you mean "second"\?`),
+			},
+		},
+	})
+}
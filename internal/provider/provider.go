@@ -14,9 +14,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	mydatasource "github.com/dstaroff/terraform-provider-units/internal/provider/datasource"
 	myfuncs "github.com/dstaroff/terraform-provider-units/internal/provider/function"
+	"github.com/dstaroff/terraform-provider-units/internal/provider/settings"
 )
 
 var _ provider.Provider = &Units{}
@@ -93,14 +95,19 @@ resource "another_cloud_provider_disk" "that" {
 
 ## Liability
 
-This provider is not intended to do automatic rounding and outputs conversion results as is.
+By default, this provider does not round and outputs conversion results as is. Set ` + "`decimal_places`" + ` to round results.
 Since results are ` + "`number`s" + `, they can be both ` + "`int`s" + ` and ` + "`float`s." + `
 
 Do not forget checking computed values and provide additional handling logic.
 `
 
 // UnitsModel describes the provider data model.
-type UnitsModel struct{}
+type UnitsModel struct {
+	settings.Model
+
+	Strict      types.Bool        `tfsdk:"strict"`
+	CustomUnits []CustomUnitModel `tfsdk:"custom_unit"`
+}
 
 func (p *Units) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "units"
@@ -108,10 +115,22 @@ func (p *Units) Metadata(_ context.Context, _ provider.MetadataRequest, resp *pr
 }
 
 func (p *Units) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	attributes := settings.ProviderAttributes()
+	attributes["strict"] = schema.BoolAttribute{
+		Description: "Whether conversion results of data sources, which are rounded to precision_bits, are errors instead of warnings. " +
+			"Results rounded to decimal_places are checked after rounding. Defaults to false.",
+		MarkdownDescription: "Whether conversion results of data sources, which are rounded to `precision_bits`, are errors instead of warnings. " +
+			"Results rounded to `decimal_places` are checked after rounding. Defaults to `false`.",
+		Optional: true,
+	}
+
 	resp.Schema = schema.Schema{
 		Description:         unitsDescription,
 		MarkdownDescription: unitsDescriptionMd,
-		Attributes:          map[string]schema.Attribute{},
+		Attributes:          attributes,
+		Blocks: map[string]schema.Block{
+			"custom_unit": customUnitBlock(),
+		},
 	}
 }
 
@@ -122,6 +141,20 @@ func (p *Units) Configure(ctx context.Context, req provider.ConfigureRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	providerData := &mydatasource.ProviderData{
+		Settings: data.Model,
+		Strict:   data.Strict.ValueBool(),
+	}
+
+	registry, diags := customUnitsRegistry(data.CustomUnits)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	providerData.Registry = registry
+
+	resp.DataSourceData = providerData
 }
 
 func (p *Units) Resources(_ context.Context) []func() resource.Resource {
@@ -131,12 +164,15 @@ func (p *Units) Resources(_ context.Context) []func() resource.Resource {
 func (p *Units) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		mydatasource.NewDataSize,
+		mydatasource.NewQuantity,
+		mydatasource.NewCatalog,
 	}
 }
 
 func (p *Units) Functions(_ context.Context) []func() function.Function {
 	var res []func() function.Function
-	res = append(res, myfuncs.GeneratedFunctions...)
+	res = append(res, myfuncs.GeneratedFunctions()...)
+	res = append(res, myfuncs.NewNormalizeUnit)
 	return res
 }
 
@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package settings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+const overrideDescription = " Overrides the setting of the provider configuration."
+
+func roundingModes() []string {
+	var res []string
+	for _, mode := range units.RoundingModes {
+		res = append(res, string(mode))
+	}
+
+	return res
+}
+
+func unitSystems() []string {
+	var res []string
+	for _, system := range units.UnitSystems {
+		res = append(res, system.Name)
+	}
+
+	return res
+}
+
+// enumerate lists values enclosed in quote, e.g. "a, b or c".
+func enumerate(values []string, quote string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, quote+value+quote)
+	}
+	if len(quoted) < 2 {
+		return strings.Join(quoted, "")
+	}
+
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+}
+
+var (
+	precisionBitsDescription = fmt.Sprintf(
+		"Precision of conversion results in bits from 1 to %d. "+
+			"By default, results have the precision of input values, but at least 53 bits. "+
+			"Data sources warn about results rounded to this precision.", converter.MaxPrecision,
+	)
+	precisionBitsDescriptionMd = fmt.Sprintf(
+		"Precision of conversion results in bits from `1` to `%d`. "+
+			"By default, results have the precision of input values, but at least `53` bits. "+
+			"Data sources warn about results rounded to this precision.", converter.MaxPrecision,
+	)
+
+	roundingModeDescription   = fmt.Sprintf("Mode of rounding results to decimal_places and formatted data sizes to format_decimal_places: %s. Defaults to half_even.", enumerate(roundingModes(), ""))
+	roundingModeDescriptionMd = fmt.Sprintf("Mode of rounding results to `decimal_places` and formatted data sizes to `format_decimal_places`: %s. Defaults to `half_even`.", enumerate(roundingModes(), "`"))
+
+	decimalPlacesDescription = fmt.Sprintf(
+		"Number of decimal places from 0 to %d, which results are rounded to with rounding_mode. By default, results are not rounded.", MaxDecimalPlaces,
+	)
+	decimalPlacesDescriptionMd = fmt.Sprintf(
+		"Number of decimal places from `0` to `%d`, which results are rounded to with `rounding_mode`. By default, results are not rounded.", MaxDecimalPlaces,
+	)
+
+	formatDecimalPlacesDescription = fmt.Sprintf(
+		"Number of decimal places from 0 to %d, which formatted data sizes, e.g. human, are rounded to with rounding_mode. Defaults to %d.",
+		MaxDecimalPlaces, DefaultFormatDecimalPlaces,
+	)
+	formatDecimalPlacesDescriptionMd = fmt.Sprintf(
+		"Number of decimal places from `0` to `%d`, which formatted data sizes, e.g. `human`, are rounded to with `rounding_mode`. Defaults to `%d`.",
+		MaxDecimalPlaces, DefaultFormatDecimalPlaces,
+	)
+
+	unitSystemDescription   = fmt.Sprintf("Unit system of automatically formatted data sizes: %s. Defaults to iec.", enumerate(unitSystems(), ""))
+	unitSystemDescriptionMd = fmt.Sprintf("Unit system of automatically formatted data sizes: %s. Defaults to `iec`.", enumerate(unitSystems(), "`"))
+)
+
+func precisionBitsValidators() []validator.Int64 {
+	return []validator.Int64{int64validator.Between(1, int64(converter.MaxPrecision))}
+}
+
+func roundingModeValidators() []validator.String {
+	return []validator.String{stringvalidator.OneOf(roundingModes()...)}
+}
+
+func decimalPlacesValidators() []validator.Int64 {
+	return []validator.Int64{int64validator.Between(0, MaxDecimalPlaces)}
+}
+
+func unitSystemValidators() []validator.String {
+	return []validator.String{stringvalidator.OneOf(unitSystems()...)}
+}
+
+// ProviderAttributes returns attributes of settings in the provider schema.
+func ProviderAttributes() map[string]providerschema.Attribute {
+	return map[string]providerschema.Attribute{
+		"precision_bits": providerschema.Int64Attribute{
+			Description:         precisionBitsDescription,
+			MarkdownDescription: precisionBitsDescriptionMd,
+			Optional:            true,
+			Validators:          precisionBitsValidators(),
+		},
+		"rounding_mode": providerschema.StringAttribute{
+			Description:         roundingModeDescription,
+			MarkdownDescription: roundingModeDescriptionMd,
+			Optional:            true,
+			Validators:          roundingModeValidators(),
+		},
+		"decimal_places": providerschema.Int64Attribute{
+			Description:         decimalPlacesDescription,
+			MarkdownDescription: decimalPlacesDescriptionMd,
+			Optional:            true,
+			Validators:          decimalPlacesValidators(),
+		},
+		"unit_system": providerschema.StringAttribute{
+			Description:         unitSystemDescription,
+			MarkdownDescription: unitSystemDescriptionMd,
+			Optional:            true,
+			Validators:          unitSystemValidators(),
+		},
+		"format_decimal_places": providerschema.Int64Attribute{
+			Description:         formatDecimalPlacesDescription,
+			MarkdownDescription: formatDecimalPlacesDescriptionMd,
+			Optional:            true,
+			Validators:          decimalPlacesValidators(),
+		},
+	}
+}
+
+// DataSourceAttributes returns attributes of settings in data source schemas, which override the provider configuration.
+func DataSourceAttributes() map[string]datasourceschema.Attribute {
+	return map[string]datasourceschema.Attribute{
+		"precision_bits": datasourceschema.Int64Attribute{
+			Description:         precisionBitsDescription + overrideDescription,
+			MarkdownDescription: precisionBitsDescriptionMd + overrideDescription,
+			Optional:            true,
+			Validators:          precisionBitsValidators(),
+		},
+		"rounding_mode": datasourceschema.StringAttribute{
+			Description:         roundingModeDescription + overrideDescription,
+			MarkdownDescription: roundingModeDescriptionMd + overrideDescription,
+			Optional:            true,
+			Validators:          roundingModeValidators(),
+		},
+		"decimal_places": datasourceschema.Int64Attribute{
+			Description:         decimalPlacesDescription + overrideDescription,
+			MarkdownDescription: decimalPlacesDescriptionMd + overrideDescription,
+			Optional:            true,
+			Validators:          decimalPlacesValidators(),
+		},
+		"unit_system": datasourceschema.StringAttribute{
+			Description:         unitSystemDescription + overrideDescription,
+			MarkdownDescription: unitSystemDescriptionMd + overrideDescription,
+			Optional:            true,
+			Validators:          unitSystemValidators(),
+		},
+		"format_decimal_places": datasourceschema.Int64Attribute{
+			Description:         formatDecimalPlacesDescription + overrideDescription,
+			MarkdownDescription: formatDecimalPlacesDescriptionMd + overrideDescription,
+			Optional:            true,
+			Validators:          decimalPlacesValidators(),
+		},
+	}
+}
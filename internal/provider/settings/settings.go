@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+// Package settings defines conversion settings, which are set in the provider configuration and overridden by data sources.
+package settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/internal/converter"
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+const (
+	// MaxDecimalPlaces is the maximal number of decimal places results are rounded to.
+	MaxDecimalPlaces = 64
+	// DefaultFormatDecimalPlaces is the number of decimal places formatted quantities are rounded to by default.
+	DefaultFormatDecimalPlaces = 2
+)
+
+// Model describes conversion settings. Null values are inherited from the provider configuration or defaults.
+type Model struct {
+	PrecisionBits types.Int64  `tfsdk:"precision_bits"`
+	RoundingMode  types.String `tfsdk:"rounding_mode"`
+	DecimalPlaces types.Int64  `tfsdk:"decimal_places"`
+	UnitSystem    types.String `tfsdk:"unit_system"`
+
+	FormatDecimalPlaces types.Int64 `tfsdk:"format_decimal_places"`
+}
+
+// Override returns m with the settings set in other.
+func (m Model) Override(other Model) Model {
+	if !other.PrecisionBits.IsNull() {
+		m.PrecisionBits = other.PrecisionBits
+	}
+	if !other.RoundingMode.IsNull() {
+		m.RoundingMode = other.RoundingMode
+	}
+	if !other.DecimalPlaces.IsNull() {
+		m.DecimalPlaces = other.DecimalPlaces
+	}
+	if !other.UnitSystem.IsNull() {
+		m.UnitSystem = other.UnitSystem
+	}
+	if !other.FormatDecimalPlaces.IsNull() {
+		m.FormatDecimalPlaces = other.FormatDecimalPlaces
+	}
+
+	return m
+}
+
+// Options returns conversion options of m with units of registry.
+func (m Model) Options(registry *units.Registry) converter.Options {
+	options := converter.Options{
+		Registry:   registry,
+		UnitSystem: units.IEC,
+		Formatting: units.Rounding{Places: DefaultFormatDecimalPlaces, Mode: units.RoundHalfEven},
+	}
+	if !m.RoundingMode.IsNull() && !m.RoundingMode.IsUnknown() {
+		options.Formatting.Mode = units.RoundingMode(m.RoundingMode.ValueString())
+	}
+	if !m.FormatDecimalPlaces.IsNull() && !m.FormatDecimalPlaces.IsUnknown() {
+		options.Formatting.Places = int(m.FormatDecimalPlaces.ValueInt64())
+	}
+
+	if !m.PrecisionBits.IsNull() && !m.PrecisionBits.IsUnknown() {
+		options.Precision = uint(m.PrecisionBits.ValueInt64())
+	}
+	if !m.DecimalPlaces.IsNull() && !m.DecimalPlaces.IsUnknown() {
+		options.Rounding = &units.Rounding{
+			Places: int(m.DecimalPlaces.ValueInt64()),
+			Mode:   options.Formatting.Mode,
+		}
+	}
+	for _, system := range units.UnitSystems {
+		if system.Name == m.UnitSystem.ValueString() {
+			options.UnitSystem = system
+		}
+	}
+
+	return options
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package settings_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/internal/provider/settings"
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+func null() settings.Model {
+	return settings.Model{
+		PrecisionBits: types.Int64Null(),
+		RoundingMode:  types.StringNull(),
+		DecimalPlaces: types.Int64Null(),
+		UnitSystem:    types.StringNull(),
+
+		FormatDecimalPlaces: types.Int64Null(),
+	}
+}
+
+func TestModelOptions_Defaults(t *testing.T) {
+	options := null().Options(nil)
+	if options.Precision != 0 {
+		t.Errorf("expected no precision, got %d", options.Precision)
+	}
+	if options.Rounding != nil {
+		t.Errorf("expected no rounding, got %v", *options.Rounding)
+	}
+	if options.UnitSystem.Name != units.IEC.Name {
+		t.Errorf("expected %s, got %s", units.IEC.Name, options.UnitSystem.Name)
+	}
+	if expected := (units.Rounding{Places: settings.DefaultFormatDecimalPlaces, Mode: units.RoundHalfEven}); options.Formatting != expected {
+		t.Errorf("expected formatting %v, got %v", expected, options.Formatting)
+	}
+}
+
+func TestModelOverride(t *testing.T) {
+	provider := null()
+	provider.PrecisionBits = types.Int64Value(128)
+	provider.DecimalPlaces = types.Int64Value(2)
+	provider.RoundingMode = types.StringValue(string(units.RoundFloor))
+
+	dataSource := null()
+	dataSource.DecimalPlaces = types.Int64Value(0)
+	dataSource.UnitSystem = types.StringValue(units.SI.Name)
+
+	options := provider.Override(dataSource).Options(nil)
+	if options.Precision != 128 {
+		t.Errorf("expected precision 128, got %d", options.Precision)
+	}
+	if options.Rounding == nil || *options.Rounding != (units.Rounding{Places: 0, Mode: units.RoundFloor}) {
+		t.Errorf("expected rounding to 0 places with floor, got %v", options.Rounding)
+	}
+	if options.UnitSystem.Name != units.SI.Name {
+		t.Errorf("expected %s, got %s", units.SI.Name, options.UnitSystem.Name)
+	}
+	if expected := (units.Rounding{Places: settings.DefaultFormatDecimalPlaces, Mode: units.RoundFloor}); options.Formatting != expected {
+		t.Errorf("expected formatting %v, got %v", expected, options.Formatting)
+	}
+}
+
+func TestModelOptions_DefaultRoundingMode(t *testing.T) {
+	model := null()
+	model.DecimalPlaces = types.Int64Value(3)
+
+	options := model.Options(nil)
+	if options.Rounding == nil || options.Rounding.Mode != units.RoundHalfEven {
+		t.Errorf("expected %s rounding, got %v", units.RoundHalfEven, options.Rounding)
+	}
+}
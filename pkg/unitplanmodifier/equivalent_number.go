@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unitplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+var _ planmodifier.Number = EquivalentNumberModifier{}
+
+// EquivalentNumberModifier keeps the prior state value in plan when it describes the same quantity in a unit as the planned value.
+type EquivalentNumberModifier struct {
+	unit units.Unit
+	base units.Unit
+}
+
+// UseStateIfEquivalentQuantityIn returns a plan modifier of numbers, which are values in unit, e.g. "gibibytes".
+// It keeps the prior state value when it describes the same quantity as the planned value,
+// once both are taken as the shortest decimals representing them and converted to the base unit of the category of unit.
+// So 0.1 held with 512 bits of precision in state is equivalent to 0.1 held with 53 bits in a configuration.
+//
+// Terraform allows planned values to differ from configuration only for computed attributes,
+// so attributes using the modifier must be Optional and Computed.
+// It panics if unit is not registered in the Default registry.
+func UseStateIfEquivalentQuantityIn(unit string) EquivalentNumberModifier {
+	u, err := units.Default.Lookup(unit)
+	if err != nil {
+		panic(err)
+	}
+	category, ok := units.Default.UnitCategory(u)
+	if !ok {
+		panic(fmt.Sprintf("unit %q belongs to no category", unit))
+	}
+
+	return EquivalentNumberModifier{unit: u, base: category.Base}
+}
+
+func (m EquivalentNumberModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("The value in state will not change if it describes the same %s in %s as the configured value.", strings.ReplaceAll(m.unit.Category, "_", " "), m.unit.Name)
+}
+
+func (m EquivalentNumberModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m EquivalentNumberModifier) PlanModifyNumber(_ context.Context, req planmodifier.NumberRequest, resp *planmodifier.NumberResponse) {
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	state, ok := m.quantity(req.StateValue.ValueBigFloat())
+	if !ok {
+		return
+	}
+	plan, ok := m.quantity(req.PlanValue.ValueBigFloat())
+	if !ok {
+		return
+	}
+
+	if state.Value.Cmp(plan.Value) == 0 {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// quantity returns value in the unit of m converted to the base unit of its category.
+func (m EquivalentNumberModifier) quantity(value *big.Float) (units.Quantity, bool) {
+	r, ok := units.Decimal(value)
+	if !ok {
+		return units.Quantity{}, false
+	}
+
+	q, err := units.NewQuantity(r, m.unit).In(m.base)
+	return q, err == nil
+}
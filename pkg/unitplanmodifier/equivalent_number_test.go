@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unitplanmodifier_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/unitplanmodifier"
+)
+
+func TestUseStateIfEquivalentQuantityIn(t *testing.T) {
+	precise, _, _ := big.ParseFloat("0.1", 10, 512, big.ToNearestEven)
+	nearMiss, _, _ := big.ParseFloat("0.10000000000000000001", 10, 512, big.ToNearestEven)
+
+	for _, tc := range []struct {
+		name     string
+		state    types.Number
+		plan     types.Number
+		expected types.Number
+	}{
+		{name: "more precise state", state: types.NumberValue(precise), plan: types.NumberValue(big.NewFloat(0.1)), expected: types.NumberValue(precise)},
+		{name: "different", state: types.NumberValue(precise), plan: types.NumberValue(big.NewFloat(0.2)), expected: types.NumberValue(big.NewFloat(0.2))},
+		{name: "near miss", state: types.NumberValue(nearMiss), plan: types.NumberValue(big.NewFloat(0.1)), expected: types.NumberValue(big.NewFloat(0.1))},
+		{name: "no state", state: types.NumberNull(), plan: types.NumberValue(big.NewFloat(0.1)), expected: types.NumberValue(big.NewFloat(0.1))},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := planmodifier.NumberRequest{ConfigValue: tc.plan, PlanValue: tc.plan, StateValue: tc.state}
+			resp := &planmodifier.NumberResponse{PlanValue: req.PlanValue}
+			unitplanmodifier.UseStateIfEquivalentQuantityIn("gibibytes").PlanModifyNumber(context.Background(), req, resp)
+			if !resp.PlanValue.Equal(tc.expected) {
+				t.Errorf("expected %s, got %s", tc.expected, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestUseStateIfEquivalentQuantityIn_Description(t *testing.T) {
+	expected := "The value in state will not change if it describes the same data size in gibibytes as the configured value."
+	if actual := unitplanmodifier.UseStateIfEquivalentQuantityIn("GiB").Description(context.Background()); actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestUseStateIfEquivalentQuantityIn_UnknownUnit(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	unitplanmodifier.UseStateIfEquivalentQuantityIn("dollars")
+}
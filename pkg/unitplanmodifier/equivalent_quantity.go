@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+// Package unitplanmodifier provides terraform-plugin-framework plan modifiers,
+// which suppress differences between equivalent representations of quantities in existing attributes.
+package unitplanmodifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+var _ planmodifier.String = EquivalentQuantityModifier{}
+
+// EquivalentQuantityModifier keeps the prior state value in plan when it describes the same quantity as the planned value.
+type EquivalentQuantityModifier struct {
+	category units.Category
+}
+
+// UseStateIfEquivalentQuantity returns a plan modifier which keeps the prior state value
+// when it describes the same quantity of category as the planned value, e.g. "1Gi" and "1024Mi" of "data_size".
+//
+// Values are quantities with units, which are parsed with the Default registry.
+// Number attributes holding values in a unit use UseStateIfEquivalentQuantityIn instead.
+//
+// Terraform allows planned values to differ from configuration only for computed attributes,
+// so attributes using the modifier must be Optional and Computed.
+// It panics if category is not registered in the Default registry.
+func UseStateIfEquivalentQuantity(category string) EquivalentQuantityModifier {
+	c, ok := units.Default.Category(category)
+	if !ok {
+		panic(fmt.Sprintf("unknown category %q", category))
+	}
+
+	return EquivalentQuantityModifier{category: c}
+}
+
+func (m EquivalentQuantityModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("The value in state will not change if it describes the same %s as the configured value.", strings.ReplaceAll(m.category.Name, "_", " "))
+}
+
+func (m EquivalentQuantityModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m EquivalentQuantityModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.StateValue.IsUnknown() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	if req.StateValue.Equal(req.PlanValue) {
+		return
+	}
+
+	state, err := m.parse(req.StateValue.ValueString())
+	if err != nil {
+		return
+	}
+	plan, err := m.parse(req.PlanValue.ValueString())
+	if err != nil {
+		return
+	}
+
+	if cmp, err := state.Cmp(plan); err == nil && cmp == 0 {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// parse parses s into a quantity of the category of m. Durations are also accepted in Go syntax, e.g. "15m".
+func (m EquivalentQuantityModifier) parse(s string) (units.Quantity, error) {
+	if m.category.Name == units.Duration.Name {
+		return units.ParseDuration(s)
+	}
+
+	q, err := units.Default.ParseQuantity(s)
+	if err != nil {
+		return units.Quantity{}, err
+	}
+	if !units.Compatible(q.Unit, m.category.Base) {
+		return units.Quantity{}, fmt.Errorf("%w: %q is not a quantity of %s", units.ErrIncompatibleUnits, s, m.category.Name)
+	}
+
+	return q, nil
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unitplanmodifier_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/unitplanmodifier"
+)
+
+func TestUseStateIfEquivalentQuantity_String(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		category string
+		state    types.String
+		plan     types.String
+		expected types.String
+	}{
+		{name: "equivalent", category: "data_size", state: types.StringValue("1Gi"), plan: types.StringValue("1024Mi"), expected: types.StringValue("1Gi")},
+		{name: "bits", category: "data_size", state: types.StringValue("1 GiB"), plan: types.StringValue("8 Gibit"), expected: types.StringValue("1 GiB")},
+		{name: "different", category: "data_size", state: types.StringValue("1GB"), plan: types.StringValue("1GiB"), expected: types.StringValue("1GiB")},
+		{name: "other category", category: "data_size", state: types.StringValue("60 s"), plan: types.StringValue("1 min"), expected: types.StringValue("1 min")},
+		{name: "malformed", category: "data_size", state: types.StringValue("1Gi"), plan: types.StringValue("1 Gi Gi"), expected: types.StringValue("1 Gi Gi")},
+		{name: "go duration", category: "duration", state: types.StringValue("90m"), plan: types.StringValue("1.5 h"), expected: types.StringValue("90m")},
+		{name: "no state", category: "data_size", state: types.StringNull(), plan: types.StringValue("1Gi"), expected: types.StringValue("1Gi")},
+		{name: "unknown plan", category: "data_size", state: types.StringValue("1Gi"), plan: types.StringUnknown(), expected: types.StringUnknown()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{ConfigValue: tc.plan, PlanValue: tc.plan, StateValue: tc.state}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			unitplanmodifier.UseStateIfEquivalentQuantity(tc.category).PlanModifyString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tc.expected) {
+				t.Errorf("expected %s, got %s", tc.expected, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestUseStateIfEquivalentQuantity_UnknownCategory(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	unitplanmodifier.UseStateIfEquivalentQuantity("money")
+}
@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// ErrExponentOutOfRange is returned when an exponent of a compound unit or its dimension exceeds MaxExponent.
+var ErrExponentOutOfRange = errors.New("exponent is out of range")
+
+// MaxExponent is the maximal absolute exponent of units and dimensions.
+const MaxExponent = math.MaxInt8
+
+// factor is a unit symbol raised to a power within a compound unit.
+type factor struct {
+	symbol   string
+	exponent int
+	// scale is the scale of the unit, not raised to exponent.
+	scale *big.Rat
+}
+
+// Power is a unit raised to an integer exponent, a building block of compound units.
+type Power struct {
+	Unit     Unit
+	Exponent int
+}
+
+// Mul returns the product of linear units a and b, e.g. GB·h.
+func Mul(a, b Unit) (Unit, error) {
+	return Compose(Power{a, 1}, Power{b, 1})
+}
+
+// Div returns the quotient of linear units a and b, e.g. GiB/s.
+func Div(a, b Unit) (Unit, error) {
+	return Compose(Power{a, 1}, Power{b, -1})
+}
+
+// Pow returns linear unit u raised to exponent, e.g. m^2.
+func Pow(u Unit, exponent int) (Unit, error) {
+	return Compose(Power{u, exponent})
+}
+
+// Compose returns the product of powers of linear units.
+// Exponents of units and of the resulting dimension must not exceed MaxExponent.
+//
+// A compound unit belongs to no category and is converted to any unit of the same dimension.
+// Factors with the same symbol are merged, so GiB·GiB is GiB^2 and GiB/GiB is dimensionless.
+func Compose(powers ...Power) (Unit, error) {
+	var dimension Dimension
+	var factors []factor
+
+	var exponents [numBaseDimensions]int
+	for _, p := range powers {
+		if p.Unit.Kind != KindLinear {
+			return Unit{}, fmt.Errorf("%w: cannot compose %s", ErrNonLinearUnit, p.Unit.Name)
+		}
+		if p.Exponent < -MaxExponent || p.Exponent > MaxExponent {
+			return Unit{}, fmt.Errorf("%w: %s^%d", ErrExponentOutOfRange, p.Unit.Symbol, p.Exponent)
+		}
+
+		for i, exponent := range p.Unit.Dimension {
+			exponents[i] += int(exponent) * p.Exponent
+		}
+		for _, f := range p.Unit.factorList() {
+			factors = mergeFactor(factors, factor{symbol: f.symbol, exponent: f.exponent * p.Exponent, scale: f.scale})
+		}
+	}
+
+	for i, exponent := range exponents {
+		if exponent < -MaxExponent || exponent > MaxExponent {
+			return Unit{}, fmt.Errorf("%w: %s^%d", ErrExponentOutOfRange, BaseDimension(i), exponent)
+		}
+		dimension[i] = int8(exponent)
+	}
+	for _, f := range factors {
+		if f.exponent < -MaxExponent || f.exponent > MaxExponent {
+			return Unit{}, fmt.Errorf("%w: %s^%d", ErrExponentOutOfRange, f.symbol, f.exponent)
+		}
+	}
+
+	// The scale is computed once the exponents are merged and checked, so its size is bounded by MaxExponent.
+	scale := big.NewRat(1, 1)
+	for _, f := range factors {
+		scale.Mul(scale, ratPow(f.scale, f.exponent))
+	}
+
+	symbol := formatFactors(factors)
+
+	return Unit{
+		Name:      symbol,
+		Symbol:    symbol,
+		Dimension: dimension,
+		Kind:      KindLinear,
+		Scale:     scale,
+		factors:   factors,
+	}, nil
+}
+
+// IsCompound reports whether u is composed of other units.
+func (u Unit) IsCompound() bool {
+	return u.factors != nil
+}
+
+func (u Unit) factorList() []factor {
+	if u.factors != nil {
+		return u.factors
+	}
+
+	return []factor{{symbol: u.Symbol, exponent: 1, scale: u.Scale}}
+}
+
+func mergeFactor(factors []factor, f factor) []factor {
+	for i := range factors {
+		if factors[i].symbol != f.symbol {
+			continue
+		}
+
+		factors[i].exponent += f.exponent
+		if factors[i].exponent == 0 {
+			return append(factors[:i], factors[i+1:]...)
+		}
+
+		return factors
+	}
+
+	if f.exponent == 0 {
+		return factors
+	}
+
+	return append(factors, f)
+}
+
+// formatFactors formats factors as numerator and denominator, e.g. "kg·m/s^2" or "1/(GB·h)".
+func formatFactors(factors []factor) string {
+	var numerator, denominator []string
+	for _, f := range factors {
+		switch {
+		case f.exponent == 1:
+			numerator = append(numerator, f.symbol)
+		case f.exponent > 1:
+			numerator = append(numerator, fmt.Sprintf("%s^%d", f.symbol, f.exponent))
+		case f.exponent == -1:
+			denominator = append(denominator, f.symbol)
+		default:
+			denominator = append(denominator, fmt.Sprintf("%s^%d", f.symbol, -f.exponent))
+		}
+	}
+
+	res := strings.Join(numerator, "·")
+	if len(numerator) == 0 {
+		res = "1"
+	}
+
+	switch len(denominator) {
+	case 0:
+		return res
+	case 1:
+		return res + "/" + denominator[0]
+	default:
+		return res + "/(" + strings.Join(denominator, "·") + ")"
+	}
+}
+
+func ratPow(r *big.Rat, exponent int) *big.Rat {
+	res := big.NewRat(1, 1)
+	base := r
+	if exponent < 0 {
+		base = new(big.Rat).Inv(r)
+		exponent = -exponent
+	}
+
+	for i := 0; i < exponent; i++ {
+		res.Mul(res, base)
+	}
+
+	return res
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+func mustCompose(t *testing.T, powers ...units.Power) units.Unit {
+	t.Helper()
+
+	unit, err := units.Compose(powers...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return unit
+}
+
+func TestCompose_Convert(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		from     units.Unit
+		to       units.Unit
+		value    *big.Rat
+		expected *big.Rat
+	}{{
+		name:     "rate",
+		from:     mustCompose(t, units.Power{Unit: units.Gibibytes, Exponent: 1}, units.Power{Unit: units.Seconds, Exponent: -1}),
+		to:       mustCompose(t, units.Power{Unit: units.Megabits, Exponent: 1}, units.Power{Unit: units.Seconds, Exponent: -1}),
+		value:    big.NewRat(1, 1),
+		expected: big.NewRat(8589934592, 1000000),
+	}, {
+		name:     "product",
+		from:     mustCompose(t, units.Power{Unit: units.Gigabytes, Exponent: 1}, units.Power{Unit: units.Months, Exponent: 1}),
+		to:       mustCompose(t, units.Power{Unit: units.Gibibytes, Exponent: 1}, units.Power{Unit: units.Hours, Exponent: 1}),
+		value:    big.NewRat(1, 1),
+		expected: big.NewRat(2629746*1000000000, 1073741824*3600),
+	}, {
+		name:     "simple and compound",
+		from:     units.Gibibytes,
+		to:       mustCompose(t, units.Power{Unit: units.Mebibytes, Exponent: 1}),
+		value:    big.NewRat(2, 1),
+		expected: big.NewRat(2048, 1),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := units.Convert(tc.value, tc.from, tc.to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Cmp(tc.expected) != 0 {
+				t.Errorf("expected %s %s, got %s", tc.expected.RatString(), tc.to.Symbol, res.RatString())
+			}
+		})
+	}
+}
+
+func TestCompose_Symbol(t *testing.T) {
+	for _, tc := range []struct {
+		unit      units.Unit
+		symbol    string
+		dimension units.Dimension
+	}{{
+		unit:      mustCompose(t, units.Power{Unit: units.Gibibytes, Exponent: 1}, units.Power{Unit: units.Seconds, Exponent: -2}),
+		symbol:    "GiB/s^2",
+		dimension: units.DimensionInformation.Div(units.DimensionTime.Pow(2)),
+	}, {
+		unit:      mustCompose(t, units.Power{Unit: units.Seconds, Exponent: 1}, units.Power{Unit: units.Gigabytes, Exponent: -1}, units.Power{Unit: units.Hours, Exponent: -1}),
+		symbol:    "s/(GB·h)",
+		dimension: units.DimensionInformation.Pow(-1),
+	}, {
+		unit:      mustCompose(t, units.Power{Unit: units.Gibibytes, Exponent: 1}, units.Power{Unit: units.Gibibytes, Exponent: 1}),
+		symbol:    "GiB^2",
+		dimension: units.DimensionInformation.Pow(2),
+	}, {
+		unit:      mustCompose(t, units.Power{Unit: units.Gibibytes, Exponent: 1}, units.Power{Unit: units.Gibibytes, Exponent: -1}),
+		symbol:    "1",
+		dimension: units.Dimensionless,
+	}} {
+		t.Run(tc.symbol, func(t *testing.T) {
+			if tc.unit.Symbol != tc.symbol {
+				t.Errorf("expected symbol %q, got %q", tc.symbol, tc.unit.Symbol)
+			}
+			if tc.unit.Dimension != tc.dimension {
+				t.Errorf("expected %s, got %s", tc.dimension, tc.unit.Dimension)
+			}
+		})
+	}
+}
+
+func TestCompose_MergedExponents(t *testing.T) {
+	// Exponents of the same unit are merged before the scale is computed, so canceled powers are never multiplied.
+	var powers []units.Power
+	for i := 0; i < 1000; i++ {
+		powers = append(powers, units.Power{Unit: units.Petabytes, Exponent: units.MaxExponent}, units.Power{Unit: units.Petabytes, Exponent: -units.MaxExponent})
+	}
+	unit := mustCompose(t, append(powers, units.Power{Unit: units.Kibibytes, Exponent: 2})...)
+
+	if unit.Symbol != "KiB^2" {
+		t.Errorf("expected KiB^2, got %s", unit.Symbol)
+	}
+	if expected := new(big.Rat).Mul(units.Kibibytes.Scale, units.Kibibytes.Scale); unit.Scale.Cmp(expected) != 0 {
+		t.Errorf("expected scale %s, got %s", expected, unit.Scale)
+	}
+}
+
+func TestCompose_Errors(t *testing.T) {
+	rate, err := units.Div(units.Gibibytes, units.Seconds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	volume, err := units.Mul(units.Gibibytes, units.Hours)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = units.Convert(big.NewRat(1, 1), rate, volume)
+	if !errors.Is(err, units.ErrIncompatibleUnits) {
+		t.Errorf("expected %v, got %v", units.ErrIncompatibleUnits, err)
+	}
+	if expected := "incompatible units: cannot convert GiB/s of information·time^-1 to GiB·h of information·time"; err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+
+	celsius := units.NewAffineUnit("celsius", "°C", "temperature", big.NewRat(1, 1), big.NewRat(27315, 100))
+	if _, err = units.Pow(celsius, 2); !errors.Is(err, units.ErrNonLinearUnit) {
+		t.Errorf("expected %v, got %v", units.ErrNonLinearUnit, err)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units
+
+import (
+	"math/big"
+)
+
+// Decimal returns the shortest decimal representing f as an exact ratio, e.g. 1/10 for 0.1.
+// It returns false if f is infinite.
+//
+// Numbers of Terraform configurations are written in decimal, so their shortest decimals are the values as configured.
+func Decimal(f *big.Float) (*big.Rat, bool) {
+	if f.IsInf() {
+		return nil, false
+	}
+	if IsExactDecimal(f) {
+		r, _ := f.Rat(nil)
+		return r, true
+	}
+
+	return new(big.Rat).SetString(f.Text('g', -1))
+}
+
+// IsExactDecimal reports whether the exact binary value of f is the shortest decimal representing it,
+// e.g. for 1.5 but not for 0.1. It is cheaper than Decimal and never formats f.
+//
+// A value with k fractional bits has k fractional decimal digits, and other decimals with at most k fractional digits
+// are at least 10^-k apart from it. So none of them represents f, if half of its last bit 2^(exp-prec-1) is less than 10^-k.
+func IsExactDecimal(f *big.Float) bool {
+	if f.Sign() == 0 {
+		return true
+	}
+	exp := f.MantExp(nil)
+	k := max(int(f.MinPrec())-exp, 0)
+
+	// log2(10) is less than 3.33.
+	return 333*k < 100*(int(f.Prec())+1-exp)
+}
@@ -0,0 +1,34 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+func TestDecimal(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 10000; i++ {
+		// Mantissas of few bits are short decimals, e.g. 1.5, and mantissas of many bits are not, e.g. 0.1.
+		mantissa := r.Int63n(1 << (1 + r.Intn(62)))
+		value := new(big.Float).SetMantExp(new(big.Float).SetInt64(mantissa), r.Intn(200)-100)
+		value.SetPrec(uint(4 + r.Intn(600)))
+		if r.Intn(2) == 0 {
+			value.Neg(value)
+		}
+
+		expected, _ := new(big.Rat).SetString(value.Text('g', -1))
+		actual, ok := units.Decimal(value)
+		if !ok || actual.Cmp(expected) != 0 {
+			t.Errorf("%s at %d bits: expected %s, got %s", value.Text('g', -1), value.Prec(), expected, actual)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BaseDimension is one of independent dimensions all units are composed of.
+type BaseDimension int
+
+const (
+	BaseInformation BaseDimension = iota
+	BaseTime
+	BaseLength
+	BaseMass
+	BaseTemperature
+	BaseCurrent
+	BaseAmount
+	BaseLuminosity
+
+	numBaseDimensions
+)
+
+var baseDimensionNames = [numBaseDimensions]string{
+	BaseInformation: "information",
+	BaseTime:        "time",
+	BaseLength:      "length",
+	BaseMass:        "mass",
+	BaseTemperature: "temperature",
+	BaseCurrent:     "current",
+	BaseAmount:      "amount",
+	BaseLuminosity:  "luminosity",
+}
+
+func (d BaseDimension) String() string {
+	return baseDimensionNames[d]
+}
+
+// Dimension is a vector of exponents of base dimensions, e.g. information per time.
+type Dimension [numBaseDimensions]int8
+
+var (
+	Dimensionless        = Dimension{}
+	DimensionInformation = Dimension{BaseInformation: 1}
+	DimensionTime        = Dimension{BaseTime: 1}
+	DimensionLength      = Dimension{BaseLength: 1}
+	DimensionMass        = Dimension{BaseMass: 1}
+	DimensionTemperature = Dimension{BaseTemperature: 1}
+	DimensionCurrent     = Dimension{BaseCurrent: 1}
+	DimensionAmount      = Dimension{BaseAmount: 1}
+	DimensionLuminosity  = Dimension{BaseLuminosity: 1}
+)
+
+// Mul returns the dimension of a product of quantities of d and other.
+func (d Dimension) Mul(other Dimension) Dimension {
+	for i := range d {
+		d[i] += other[i]
+	}
+
+	return d
+}
+
+// Div returns the dimension of a quotient of quantities of d and other.
+func (d Dimension) Div(other Dimension) Dimension {
+	return d.Mul(other.Pow(-1))
+}
+
+// Pow returns d raised to exponent.
+func (d Dimension) Pow(exponent int) Dimension {
+	for i := range d {
+		d[i] *= int8(exponent)
+	}
+
+	return d
+}
+
+// String formats d as a product of base dimensions, e.g. "information·time^-1".
+func (d Dimension) String() string {
+	var parts []string
+	for i, exponent := range d {
+		switch exponent {
+		case 0:
+		case 1:
+			parts = append(parts, BaseDimension(i).String())
+		default:
+			parts = append(parts, fmt.Sprintf("%s^%d", BaseDimension(i), exponent))
+		}
+	}
+
+	if len(parts) == 0 {
+		return "dimensionless"
+	}
+
+	return strings.Join(parts, "·")
+}
@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrUnknownUnit is returned when a unit is not found in a registry.
+var ErrUnknownUnit = errors.New("unknown unit")
+
+// UnknownUnitError describes a unit which is not found along with similar known names.
+type UnknownUnitError struct {
+	Name        string
+	Suggestions []string
+}
+
+func (e *UnknownUnitError) Error() string {
+	msg := fmt.Sprintf("unknown unit %q", e.Name)
+
+	switch len(e.Suggestions) {
+	case 0:
+		return msg
+	case 1:
+		return fmt.Sprintf("%s, did you mean %q?", msg, e.Suggestions[0])
+	default:
+		quoted := make([]string, 0, len(e.Suggestions))
+		for _, s := range e.Suggestions {
+			quoted = append(quoted, fmt.Sprintf("%q", s))
+		}
+
+		return fmt.Sprintf("%s, did you mean %s or %s?", msg, strings.Join(quoted[:len(quoted)-1], ", "), quoted[len(quoted)-1])
+	}
+}
+
+func (e *UnknownUnitError) Is(target error) bool {
+	return target == ErrUnknownUnit
+}
+
+const maxSuggestions = 3
+
+// suggest returns up to maxSuggestions keys similar to name, at most one per unit.
+func suggest(name string, keys []unitKey) []string {
+	type candidate struct {
+		key      string
+		distance int
+	}
+
+	name = strings.ToLower(name)
+	threshold := max(1, len(name)/4)
+
+	best := map[string]candidate{}
+	for _, key := range keys {
+		d := levenshtein(name, strings.ToLower(key.key))
+		if d > threshold {
+			continue
+		}
+		if c, ok := best[key.unit.Name]; !ok || d < c.distance {
+			best[key.unit.Name] = candidate{key: key.key, distance: d}
+		}
+	}
+
+	var candidates []candidate
+	for _, c := range best {
+		candidates = append(candidates, c)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].key < candidates[j].key
+	})
+
+	var res []string
+	for _, c := range candidates {
+		if len(res) == maxSuggestions {
+			break
+		}
+		res = append(res, c.key)
+	}
+
+	return res
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
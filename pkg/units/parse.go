@@ -0,0 +1,275 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidExpression is returned when a unit expression is malformed.
+var ErrInvalidExpression = errors.New("invalid unit expression")
+
+// MaxExpressionLength is the maximal length of unit expressions in characters.
+const MaxExpressionLength = 256
+
+// ExpressionError describes a syntax error in a unit expression.
+type ExpressionError struct {
+	Expression string
+	// Position is a zero-based index of the offending character in runes.
+	Position int
+	Message  string
+}
+
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("%s %q: %s at position %d", ErrInvalidExpression, e.Expression, e.Message, e.Position)
+}
+
+func (e *ExpressionError) Is(target error) bool {
+	return target == ErrInvalidExpression
+}
+
+var superscripts = map[rune]rune{
+	'⁰': '0', '¹': '1', '²': '2', '³': '3', '⁴': '4',
+	'⁵': '5', '⁶': '6', '⁷': '7', '⁸': '8', '⁹': '9',
+	'⁻': '-', '⁺': '+',
+}
+
+// asciiFallbacks are spellings of unit symbols without special characters, tried when a unit is not found.
+var asciiFallbacks = []struct {
+	prefix, suffix string
+	replacement    string
+}{
+	{prefix: "u", replacement: "µ"},
+	{prefix: "deg", replacement: "°"},
+	{suffix: "ohm", replacement: "Ω"},
+	{suffix: "Ohm", replacement: "Ω"},
+}
+
+// Parse parses a unit expression, e.g. "kg·m/s^2" or "MiB/s", into a unit of r.
+//
+// Units are multiplied with "*" or "·" and divided with "/", which applies to the following factor only.
+// Exponents are written with "^" or superscripts, and factors are grouped with parentheses.
+// ASCII spellings "u", "deg" and "ohm" stand for "µ", "°" and "Ω".
+//
+// An expression of a single unit returns that unit, other expressions return a compound unit with a canonical symbol.
+// Expressions longer than MaxExpressionLength are errors.
+func (r *Registry) Parse(expression string) (Unit, error) {
+	p := parser{registry: r, expression: expression, input: []rune(expression)}
+	if len(p.input) > MaxExpressionLength {
+		p.pos = MaxExpressionLength
+		return Unit{}, p.errorf("expression is longer than %d characters", MaxExpressionLength)
+	}
+
+	powers, err := p.parseProduct()
+	if err != nil {
+		return Unit{}, err
+	}
+	if p.skipSpaces(); p.pos < len(p.input) {
+		return Unit{}, p.errorf("unexpected %q", string(p.input[p.pos]))
+	}
+
+	if len(powers) == 1 && powers[0].Exponent == 1 && !p.grouped {
+		return powers[0].Unit, nil
+	}
+
+	return Compose(powers...)
+}
+
+type parser struct {
+	registry   *Registry
+	expression string
+	input      []rune
+	pos        int
+	// grouped is set when the expression has parentheses or operators, so a single factor is still compound.
+	grouped bool
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return &ExpressionError{Expression: p.expression, Position: p.pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *parser) peek() (rune, bool) {
+	p.skipSpaces()
+	if p.pos >= len(p.input) {
+		return 0, false
+	}
+
+	return p.input[p.pos], true
+}
+
+// parseProduct parses factors separated by multiplication and division.
+func (p *parser) parseProduct() ([]Power, error) {
+	powers, err := p.parseFactor(1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		c, ok := p.peek()
+		if !ok || c == ')' {
+			return powers, nil
+		}
+
+		sign := 1
+		switch c {
+		case '*', '·', '⋅':
+		case '/':
+			sign = -1
+		default:
+			return nil, p.errorf("unexpected %q", string(c))
+		}
+		p.pos++
+		p.grouped = true
+
+		factor, err := p.parseFactor(sign)
+		if err != nil {
+			return nil, err
+		}
+		powers = append(powers, factor...)
+	}
+}
+
+// parseFactor parses a unit or a parenthesized product with an optional exponent, and raises it to sign.
+func (p *parser) parseFactor(sign int) ([]Power, error) {
+	c, ok := p.peek()
+	if !ok {
+		return nil, p.errorf("expected a unit")
+	}
+
+	var powers []Power
+	switch {
+	case c == '(':
+		p.pos++
+		p.grouped = true
+
+		var err error
+		powers, err = p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		if c, ok = p.peek(); !ok || c != ')' {
+			return nil, p.errorf("expected %q", ")")
+		}
+		p.pos++
+	case c == '1':
+		p.pos++
+	case isUnitRune(c):
+		unit, err := p.parseUnit()
+		if err != nil {
+			return nil, err
+		}
+		powers = []Power{{Unit: unit, Exponent: 1}}
+	default:
+		return nil, p.errorf("unexpected %q", string(c))
+	}
+
+	exponent, err := p.parseExponent()
+	if err != nil {
+		return nil, err
+	}
+	for i := range powers {
+		powers[i].Exponent *= exponent * sign
+		// Exponents of nested groups are checked on every level, so they never overflow.
+		if powers[i].Exponent < -MaxExponent || powers[i].Exponent > MaxExponent {
+			return nil, fmt.Errorf("%w: %s^%d", ErrExponentOutOfRange, powers[i].Unit.Symbol, powers[i].Exponent)
+		}
+	}
+
+	return powers, nil
+}
+
+func (p *parser) parseUnit() (Unit, error) {
+	start := p.pos
+	for p.pos < len(p.input) && isUnitRune(p.input[p.pos]) {
+		p.pos++
+	}
+
+	return p.registry.lookupSymbol(string(p.input[start:p.pos]))
+}
+
+// parseExponent parses an optional "^" exponent or superscript exponent, which defaults to 1.
+func (p *parser) parseExponent() (int, error) {
+	if p.pos >= len(p.input) {
+		return 1, nil
+	}
+
+	var digits []rune
+	if p.input[p.pos] == '^' {
+		p.pos++
+		p.grouped = true
+		p.skipSpaces()
+		for p.pos < len(p.input) && (unicode.IsDigit(p.input[p.pos]) || len(digits) == 0 && (p.input[p.pos] == '-' || p.input[p.pos] == '+')) {
+			digits = append(digits, p.input[p.pos])
+			p.pos++
+		}
+	} else {
+		for p.pos < len(p.input) {
+			digit, ok := superscripts[p.input[p.pos]]
+			if !ok {
+				break
+			}
+			digits = append(digits, digit)
+			p.pos++
+		}
+		if len(digits) == 0 {
+			return 1, nil
+		}
+		p.grouped = true
+	}
+
+	exponent, err := strconv.Atoi(string(digits))
+	if err != nil {
+		return 0, p.errorf("expected an integer exponent")
+	}
+	if exponent < -MaxExponent || exponent > MaxExponent {
+		return 0, p.errorf("exponent must be from %d to %d", -MaxExponent, MaxExponent)
+	}
+
+	return exponent, nil
+}
+
+func isUnitRune(c rune) bool {
+	return unicode.IsLetter(c) || c == '°' || c == '_'
+}
+
+// lookupSymbol looks up a unit by name, trying ASCII spellings of special characters if it is not found.
+func (r *Registry) lookupSymbol(name string) (Unit, error) {
+	// Greek small letter mu is often typed instead of the micro sign.
+	name = strings.ReplaceAll(name, "μ", "µ")
+
+	unit, err := r.Lookup(name)
+	if err == nil {
+		return unit, nil
+	}
+
+	for _, fallback := range asciiFallbacks {
+		var candidate string
+		switch {
+		case fallback.prefix != "" && len(name) > len(fallback.prefix) && strings.HasPrefix(name, fallback.prefix):
+			candidate = fallback.replacement + strings.TrimPrefix(name, fallback.prefix)
+		case fallback.suffix != "" && strings.HasSuffix(name, fallback.suffix):
+			candidate = strings.TrimSuffix(name, fallback.suffix) + fallback.replacement
+		default:
+			continue
+		}
+
+		if unit, fallbackErr := r.Lookup(candidate); fallbackErr == nil {
+			return unit, nil
+		}
+	}
+
+	return Unit{}, err
+}
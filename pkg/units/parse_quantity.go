@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidQuantity is returned when a quantity string is malformed.
+var ErrInvalidQuantity = errors.New("invalid quantity")
+
+// maxDecimalExponent limits exponents of numbers in quantity strings, so parsing them takes bounded memory.
+const maxDecimalExponent = 1000
+
+// ParseQuantity parses a number followed by a unit expression, e.g. "1.5GiB", "1024 Mi" or "2e3 m/s".
+// The number is parsed exactly, so "0.1 GB" is exactly 100 MB.
+func (r *Registry) ParseQuantity(s string) (Quantity, error) {
+	number, expression := splitQuantity(strings.TrimSpace(s))
+	if number == "" {
+		return Quantity{}, fmt.Errorf("%w %q: expected a number", ErrInvalidQuantity, s)
+	}
+	if strings.TrimSpace(expression) == "" {
+		return Quantity{}, fmt.Errorf("%w %q: expected a unit", ErrInvalidQuantity, s)
+	}
+
+	if i := strings.IndexAny(number, "eE"); i >= 0 {
+		exponent, err := strconv.Atoi(number[i+1:])
+		if err != nil || exponent < -maxDecimalExponent || exponent > maxDecimalExponent {
+			return Quantity{}, fmt.Errorf("%w %q: exponent must be from %d to %d", ErrInvalidQuantity, s, -maxDecimalExponent, maxDecimalExponent)
+		}
+	}
+
+	value, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return Quantity{}, fmt.Errorf("%w %q: malformed number %q", ErrInvalidQuantity, s, number)
+	}
+
+	unit, err := r.Parse(expression)
+	if err != nil {
+		return Quantity{}, fmt.Errorf("%w %q: %w", ErrInvalidQuantity, s, err)
+	}
+
+	return NewQuantity(value, unit), nil
+}
+
+// ParseDuration parses a duration either in Go syntax, e.g. "1h30m" or "15m",
+// or as a quantity of time in the Default registry, e.g. "1.5 d" or "2 weeks".
+// As in Go syntax, m is minutes rather than meters, e.g. "15 m" is 15 minutes.
+func ParseDuration(s string) (Quantity, error) {
+	if d, err := time.ParseDuration(strings.TrimSpace(s)); err == nil {
+		return NewQuantity(big.NewRat(int64(d), int64(time.Second)), Seconds), nil
+	}
+
+	if number, unit := splitQuantity(strings.TrimSpace(s)); strings.TrimSpace(unit) == "m" {
+		s = number + " " + Minutes.Symbol
+	}
+
+	q, err := Default.ParseQuantity(s)
+	if err != nil {
+		return Quantity{}, err
+	}
+	if q.Unit.Dimension != DimensionTime {
+		return Quantity{}, fmt.Errorf("%w: %q is not a duration", ErrIncompatibleUnits, s)
+	}
+
+	return q, nil
+}
+
+// ParseDataSize parses a quantity of information in the Default registry, e.g. "1.5GiB", "1024 Mi" or "8 Gibit".
+func ParseDataSize(s string) (Quantity, error) {
+	q, err := Default.ParseQuantity(s)
+	if err != nil {
+		return Quantity{}, err
+	}
+	if q.Unit.Dimension != DimensionInformation {
+		return Quantity{}, fmt.Errorf("%w: %q is not a data size", ErrIncompatibleUnits, s)
+	}
+
+	return q, nil
+}
+
+// splitQuantity splits s into a leading decimal number with an optional exponent and the rest.
+func splitQuantity(s string) (number, rest string) {
+	i := 0
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		i++
+	}
+
+	digits := 0
+	for ; i < len(s) && (isDigit(s[i]) || s[i] == '.'); i++ {
+		if s[i] != '.' {
+			digits++
+		}
+	}
+	if digits == 0 {
+		return "", s
+	}
+
+	// An exponent is taken only if it has digits, so "1e" is left for the unit, e.g. "1 exabyte".
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '-' || s[j] == '+') {
+			j++
+		}
+		if j < len(s) && isDigit(s[j]) {
+			for j < len(s) && isDigit(s[j]) {
+				j++
+			}
+			i = j
+		}
+	}
+
+	return s[:i], s[i:]
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
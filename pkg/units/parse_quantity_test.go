@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+func TestRegistryParseQuantity(t *testing.T) {
+	for _, tc := range []struct {
+		quantity string
+		expected string
+	}{
+		{quantity: "1.5GiB", expected: "1.5 GiB"},
+		{quantity: " 1024 Mi ", expected: "1024 MiB"},
+		{quantity: "1Gi", expected: "1 GiB"},
+		{quantity: "-2 kB", expected: "-2 kB"},
+		{quantity: "+.5 gibibytes", expected: "0.5 GiB"},
+		{quantity: "2e3 m/s", expected: "2000 m/s"},
+		{quantity: "1E-3s", expected: "0.001 s"},
+		{quantity: "0.1 GB", expected: "0.1 GB"},
+		{quantity: "100 MiB/s", expected: "100 MiB/s"},
+	} {
+		t.Run(tc.quantity, func(t *testing.T) {
+			q, err := units.Default.ParseQuantity(tc.quantity)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if q.String() != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, q)
+			}
+		})
+	}
+}
+
+func TestRegistryParseQuantity_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		quantity string
+		target   error
+		message  string
+	}{{
+		quantity: "",
+		target:   units.ErrInvalidQuantity,
+		message:  `invalid quantity "": expected a number`,
+	}, {
+		quantity: "GiB",
+		target:   units.ErrInvalidQuantity,
+		message:  `invalid quantity "GiB": expected a number`,
+	}, {
+		quantity: "42",
+		target:   units.ErrInvalidQuantity,
+		message:  `invalid quantity "42": expected a unit`,
+	}, {
+		quantity: "1.2.3 GiB",
+		target:   units.ErrInvalidQuantity,
+		message:  `invalid quantity "1.2.3 GiB": malformed number "1.2.3"`,
+	}, {
+		quantity: "1e999999999 B",
+		target:   units.ErrInvalidQuantity,
+		message:  `invalid quantity "1e999999999 B": exponent must be from -1000 to 1000`,
+	}, {
+		quantity: "1 gibibites",
+		target:   units.ErrUnknownUnit,
+		message:  `invalid quantity "1 gibibites": unknown unit "gibibites", did you mean "gibibits", "gibibytes" or "kibibits"?`,
+	}} {
+		t.Run(tc.quantity, func(t *testing.T) {
+			_, err := units.Default.ParseQuantity(tc.quantity)
+			if !errors.Is(err, tc.target) {
+				t.Fatalf("expected %v, got %v", tc.target, err)
+			}
+			if err.Error() != tc.message {
+				t.Errorf("expected %q, got %q", tc.message, err.Error())
+			}
+		})
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	for _, tc := range []struct {
+		duration string
+		seconds  *big.Rat
+	}{
+		{duration: "15m", seconds: big.NewRat(900, 1)},
+		{duration: "15 m", seconds: big.NewRat(900, 1)},
+		{duration: "1.5m", seconds: big.NewRat(90, 1)},
+		{duration: "1.5 m", seconds: big.NewRat(90, 1)},
+		{duration: "1h30m", seconds: big.NewRat(5400, 1)},
+		{duration: "300ms", seconds: big.NewRat(3, 10)},
+		{duration: "1.5 d", seconds: big.NewRat(129600, 1)},
+		{duration: "2 weeks", seconds: big.NewRat(1209600, 1)},
+		{duration: "1000 yr", seconds: big.NewRat(31556952000, 1)},
+	} {
+		t.Run(tc.duration, func(t *testing.T) {
+			q, err := units.ParseDuration(tc.duration)
+			if err != nil {
+				t.Fatal(err)
+			}
+			seconds, err := q.In(units.Seconds)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if seconds.Value.Cmp(tc.seconds) != 0 {
+				t.Errorf("expected %s s, got %s", tc.seconds.RatString(), seconds.Value.RatString())
+			}
+		})
+	}
+
+	for _, duration := range []string{"15 MiB", "15 m/s", "15 km"} {
+		if _, err := units.ParseDuration(duration); !errors.Is(err, units.ErrIncompatibleUnits) {
+			t.Errorf("%s: expected %v, got %v", duration, units.ErrIncompatibleUnits, err)
+		}
+	}
+}
+
+func TestParseDataSize(t *testing.T) {
+	for _, tc := range []struct {
+		size  string
+		bytes *big.Rat
+	}{
+		{size: "1Gi", bytes: big.NewRat(1073741824, 1)},
+		{size: "1024 MiB", bytes: big.NewRat(1073741824, 1)},
+		{size: "8 Gibit", bytes: big.NewRat(1073741824, 1)},
+		{size: "1.5 kB", bytes: big.NewRat(1500, 1)},
+	} {
+		t.Run(tc.size, func(t *testing.T) {
+			q, err := units.ParseDataSize(tc.size)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bytes, err := q.In(units.Bytes)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bytes.Value.Cmp(tc.bytes) != 0 {
+				t.Errorf("expected %s B, got %s", tc.bytes.RatString(), bytes.Value.RatString())
+			}
+		})
+	}
+
+	if _, err := units.ParseDataSize("15 m"); !errors.Is(err, units.ErrIncompatibleUnits) {
+		t.Errorf("expected %v, got %v", units.ErrIncompatibleUnits, err)
+	}
+}
+
+func FuzzRegistryParseQuantity(f *testing.F) {
+	for _, seed := range []string{"1.5GiB", "1024 Mi", "-2 kB", "+.5 gibibytes", "2e3 m/s", "1E-3s", "15m", "1h30m", "1e", "1.2.3 GiB", ""} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		q, err := units.Default.ParseQuantity(s)
+		if err != nil {
+			if !errors.Is(err, units.ErrInvalidQuantity) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			return
+		}
+
+		// A formatted quantity is parsed into the same quantity, unless formatting rounds it.
+		exact, _ := new(big.Rat).SetString(q.Value.FloatString(18))
+		if exact.Cmp(q.Value) != 0 {
+			return
+		}
+		parsed, err := units.Default.ParseQuantity(q.String())
+		if err != nil {
+			t.Fatalf("formatted quantity %q of %q: %v", q, s, err)
+		}
+		if cmp, err := parsed.Cmp(q); err != nil || cmp != 0 {
+			t.Errorf("formatted quantity %q of %q is parsed into %q", q, s, parsed)
+		}
+	})
+}
+
+func FuzzParseDuration(f *testing.F) {
+	for _, seed := range []string{"15m", "1h30m", "300ms", "1.5 d", "2 weeks", "-1.5h", "1e3 ns", "15 MiB", ""} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		q, err := units.ParseDuration(s)
+		if err != nil {
+			if !errors.Is(err, units.ErrInvalidQuantity) && !errors.Is(err, units.ErrIncompatibleUnits) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			return
+		}
+		if q.Unit.Dimension != units.DimensionTime {
+			t.Errorf("duration %q is parsed into %s", s, q)
+		}
+	})
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+func TestRegistryParse(t *testing.T) {
+	for _, tc := range []struct {
+		expression string
+		symbol     string
+		dimension  units.Dimension
+	}{
+		{expression: "GiB", symbol: "GiB", dimension: units.DimensionInformation},
+		{expression: "MiB/s", symbol: "MiB/s", dimension: units.DimensionInformation.Div(units.DimensionTime)},
+		{expression: "kilobytes / second", symbol: "kB/s", dimension: units.DimensionInformation.Div(units.DimensionTime)},
+		{expression: "kg·m/s^2", symbol: "kg·m/s^2", dimension: units.DimensionMass.Mul(units.DimensionLength).Div(units.DimensionTime.Pow(2))},
+		{expression: "kg*m/s²", symbol: "kg·m/s^2", dimension: units.DimensionMass.Mul(units.DimensionLength).Div(units.DimensionTime.Pow(2))},
+		{expression: "kg/(m·s)", symbol: "kg/(m·s)", dimension: units.DimensionMass.Div(units.DimensionLength).Div(units.DimensionTime)},
+		{expression: "Gbit/s/s", symbol: "Gbit/s^2", dimension: units.DimensionInformation.Div(units.DimensionTime.Pow(2))},
+		{expression: "m^-1", symbol: "1/m", dimension: units.DimensionLength.Pow(-1)},
+		{expression: "m⁻¹", symbol: "1/m", dimension: units.DimensionLength.Pow(-1)},
+		{expression: "1/s", symbol: "1/s", dimension: units.DimensionTime.Pow(-1)},
+		{expression: "us", symbol: "µs", dimension: units.DimensionTime},
+		{expression: "μs", symbol: "µs", dimension: units.DimensionTime},
+		{expression: "degC", symbol: "°C", dimension: units.DimensionTemperature},
+		{expression: "kohm", symbol: "kΩ", dimension: units.Ohms.Dimension},
+	} {
+		t.Run(tc.expression, func(t *testing.T) {
+			unit, err := units.Default.Parse(tc.expression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if unit.Symbol != tc.symbol {
+				t.Errorf("expected symbol %q, got %q", tc.symbol, unit.Symbol)
+			}
+			if unit.Dimension != tc.dimension {
+				t.Errorf("expected %s, got %s", tc.dimension, unit.Dimension)
+			}
+		})
+	}
+}
+
+func TestRegistryParse_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		expression string
+		target     error
+		message    string
+	}{{
+		expression: "",
+		target:     units.ErrInvalidExpression,
+		message:    `invalid unit expression "": expected a unit at position 0`,
+	}, {
+		expression: "(kg",
+		target:     units.ErrInvalidExpression,
+		message:    `invalid unit expression "(kg": expected ")" at position 3`,
+	}, {
+		expression: "m^",
+		target:     units.ErrInvalidExpression,
+		message:    `invalid unit expression "m^": expected an integer exponent at position 2`,
+	}, {
+		expression: "GB month",
+		target:     units.ErrInvalidExpression,
+		message:    `invalid unit expression "GB month": unexpected "m" at position 3`,
+	}, {
+		expression: "GiB/sec0nd",
+		target:     units.ErrInvalidExpression,
+	}, {
+		expression: "GiB/secnd",
+		target:     units.ErrUnknownUnit,
+		message:    `unknown unit "secnd", did you mean "second"?`,
+	}, {
+		expression: "°C/s",
+		target:     units.ErrNonLinearUnit,
+	}, {
+		expression: "s^999999999",
+		target:     units.ErrInvalidExpression,
+		message:    `invalid unit expression "s^999999999": exponent must be from -127 to 127 at position 11`,
+	}, {
+		expression: "(s^100)^2",
+		target:     units.ErrExponentOutOfRange,
+		message:    "exponent is out of range: s^200",
+	}, {
+		expression: strings.Repeat("B*", 128) + "B",
+		target:     units.ErrInvalidExpression,
+		message:    fmt.Sprintf("invalid unit expression %q: expression is longer than 256 characters at position 256", strings.Repeat("B*", 128)+"B"),
+	}} {
+		t.Run(tc.expression, func(t *testing.T) {
+			_, err := units.Default.Parse(tc.expression)
+			if !errors.Is(err, tc.target) {
+				t.Fatalf("expected %v, got %v", tc.target, err)
+			}
+			if tc.message != "" && err.Error() != tc.message {
+				t.Errorf("expected %q, got %q", tc.message, err.Error())
+			}
+		})
+	}
+}
+
+func TestRegistryParse_ExponentHeavy(t *testing.T) {
+	for _, tc := range []struct {
+		expression string
+		target     error
+	}{
+		{expression: strings.Repeat("PB^127*PiB^127/", 120) + "B", target: units.ErrInvalidExpression},
+		{expression: strings.Repeat("PB^127*PiB^127/", 17) + "B", target: units.ErrExponentOutOfRange},
+		{expression: strings.Repeat("(PB^127/PiB^127)*", 15) + "B", target: units.ErrExponentOutOfRange},
+		{expression: strings.Repeat("(", 127) + "B" + strings.Repeat(")^-1", 127), target: units.ErrInvalidExpression},
+	} {
+		// Expressions are bounded in length and exponents, so they are rejected before their scales grow.
+		start := time.Now()
+		_, err := units.Default.Parse(tc.expression)
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("%.20s...: expected to be rejected quickly, took %s", tc.expression, elapsed)
+		}
+		if !errors.Is(err, tc.target) {
+			t.Errorf("%.20s...: expected %v, got %v", tc.expression, tc.target, err)
+		}
+	}
+}
+
+func FuzzRegistryParse(f *testing.F) {
+	for _, seed := range []string{"GiB", "MiB/s", "kg·m/s^2", "kg*m/s²", "kg/(m·s)", "m^-1", "1/s", "us", "degC", "kohm", "(kg", "m^", "", strings.Repeat("PB^127*PiB^127/", 17) + "B"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, expression string) {
+		unit, err := units.Default.Parse(expression)
+		if err != nil {
+			for _, target := range []error{units.ErrInvalidExpression, units.ErrUnknownUnit, units.ErrNonLinearUnit, units.ErrExponentOutOfRange} {
+				if errors.Is(err, target) {
+					return
+				}
+			}
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// A canonical symbol is parsed into the same unit.
+		normalized, err := units.Default.Parse(unit.Symbol)
+		if err != nil {
+			t.Fatalf("canonical symbol %q of %q: %v", unit.Symbol, expression, err)
+		}
+		if normalized.Symbol != unit.Symbol || normalized.Dimension != unit.Dimension || normalized.Scale.Cmp(unit.Scale) != 0 {
+			t.Errorf("canonical symbol %q of %q is parsed into %q", unit.Symbol, expression, normalized.Symbol)
+		}
+	})
+}
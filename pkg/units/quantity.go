@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var (
+	// ErrNonLinearUnit is returned when arithmetic is not defined for a unit, e.g. adding decibels.
+	ErrNonLinearUnit = errors.New("arithmetic is defined only for linear units")
+	// ErrDivisionByZero is returned when a quantity is divided by zero.
+	ErrDivisionByZero = errors.New("division by zero")
+)
+
+// Quantity is a value measured in a unit.
+type Quantity struct {
+	Value *big.Rat
+	Unit  Unit
+}
+
+// NewQuantity creates a quantity of value in unit.
+func NewQuantity(value *big.Rat, unit Unit) Quantity {
+	return Quantity{
+		Value: value,
+		Unit:  unit,
+	}
+}
+
+// String formats q as a value followed by the unit symbol, e.g. "1.5 GiB", or by the unit name if it has no symbol.
+func (q Quantity) String() string {
+	symbol := q.Unit.Symbol
+	if symbol == "" {
+		symbol = q.Unit.Name
+	}
+
+	// Values without a finite decimal representation are written with 18 digits.
+	const maxDigits = 18
+	digits, ok := DecimalDigits(q.Value)
+	if !ok {
+		digits = maxDigits
+	}
+
+	return fmt.Sprintf("%s %s", q.Value.FloatString(min(digits, maxDigits)), symbol)
+}
+
+// In converts q to unit.
+func (q Quantity) In(unit Unit) (Quantity, error) {
+	value, err := Convert(q.Value, q.Unit, unit)
+	if err != nil {
+		return Quantity{}, err
+	}
+
+	return NewQuantity(value, unit), nil
+}
+
+// Add returns the sum of q and other in the unit of q.
+func (q Quantity) Add(other Quantity) (Quantity, error) {
+	value, err := q.operand(other)
+	if err != nil {
+		return Quantity{}, err
+	}
+
+	return NewQuantity(new(big.Rat).Add(q.Value, value), q.Unit), nil
+}
+
+// Sub returns the difference of q and other in the unit of q.
+func (q Quantity) Sub(other Quantity) (Quantity, error) {
+	value, err := q.operand(other)
+	if err != nil {
+		return Quantity{}, err
+	}
+
+	return NewQuantity(new(big.Rat).Sub(q.Value, value), q.Unit), nil
+}
+
+// Mul scales q by factor.
+func (q Quantity) Mul(factor *big.Rat) (Quantity, error) {
+	if q.Unit.Kind != KindLinear {
+		return Quantity{}, fmt.Errorf("%w: cannot scale %s", ErrNonLinearUnit, q.Unit.Name)
+	}
+
+	return NewQuantity(new(big.Rat).Mul(q.Value, factor), q.Unit), nil
+}
+
+// Div divides q by divisor.
+func (q Quantity) Div(divisor *big.Rat) (Quantity, error) {
+	if divisor.Sign() == 0 {
+		return Quantity{}, ErrDivisionByZero
+	}
+
+	return q.Mul(new(big.Rat).Inv(divisor))
+}
+
+// Cmp compares q and other and returns -1 if q < other, 0 if q == other and +1 if q > other.
+func (q Quantity) Cmp(other Quantity) (int, error) {
+	value, err := Convert(other.Value, other.Unit, q.Unit)
+	if err != nil {
+		return 0, err
+	}
+
+	return q.Value.Cmp(value), nil
+}
+
+// operand converts other to the unit of q for addition or subtraction.
+func (q Quantity) operand(other Quantity) (*big.Rat, error) {
+	for _, unit := range []Unit{q.Unit, other.Unit} {
+		if unit.Kind != KindLinear {
+			return nil, fmt.Errorf("%w: cannot add or subtract %s", ErrNonLinearUnit, unit.Name)
+		}
+	}
+
+	return Convert(other.Value, other.Unit, q.Unit)
+}
+
+// DecimalDigits returns the number of fractional digits of r written as an exact decimal, e.g. 3 for 1.125.
+// It returns false if r has no finite decimal representation, e.g. for 1/3.
+func DecimalDigits(r *big.Rat) (int, bool) {
+	// A fraction has a finite decimal representation only if its denominator has no prime factors other than 2 and 5.
+	denominator := new(big.Int).Set(r.Denom())
+	digits := 0
+	for _, factor := range []int64{2, 5} {
+		f := big.NewInt(factor)
+		count := 0
+		for new(big.Int).Mod(denominator, f).Sign() == 0 {
+			denominator.Quo(denominator, f)
+			count++
+		}
+		digits = max(digits, count)
+	}
+
+	return digits, denominator.Cmp(big.NewInt(1)) == 0
+}
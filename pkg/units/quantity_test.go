@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+func TestQuantityArithmetic(t *testing.T) {
+	gib := units.NewQuantity(big.NewRat(1, 1), units.Gibibytes)
+	mib := units.NewQuantity(big.NewRat(512, 1), units.Mebibytes)
+
+	for _, tc := range []struct {
+		name     string
+		op       func() (units.Quantity, error)
+		expected string
+	}{{
+		name:     "add",
+		op:       func() (units.Quantity, error) { return gib.Add(mib) },
+		expected: "1.5 GiB",
+	}, {
+		name:     "sub",
+		op:       func() (units.Quantity, error) { return gib.Sub(mib) },
+		expected: "0.5 GiB",
+	}, {
+		name:     "mul",
+		op:       func() (units.Quantity, error) { return mib.Mul(big.NewRat(3, 1)) },
+		expected: "1536 MiB",
+	}, {
+		name:     "div",
+		op:       func() (units.Quantity, error) { return gib.Div(big.NewRat(8, 1)) },
+		expected: "0.125 GiB",
+	}, {
+		name:     "in",
+		op:       func() (units.Quantity, error) { return gib.In(units.Megabytes) },
+		expected: "1073.741824 MB",
+	}, {
+		name: "no symbol",
+		op: func() (units.Quantity, error) {
+			return units.NewQuantity(big.NewRat(2, 1), units.NewLinearUnit("slots", "", "data_size", big.NewRat(8<<30, 1))), nil
+		},
+		expected: "2 slots",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := tc.op()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.String() != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, res)
+			}
+		})
+	}
+}
+
+func TestQuantityCmp(t *testing.T) {
+	gib := units.NewQuantity(big.NewRat(1, 1), units.Gibibytes)
+
+	for _, tc := range []struct {
+		other    units.Quantity
+		expected int
+	}{{
+		other:    units.NewQuantity(big.NewRat(1024, 1), units.Mebibytes),
+		expected: 0,
+	}, {
+		other:    units.NewQuantity(big.NewRat(1, 1), units.Gigabytes),
+		expected: 1,
+	}, {
+		other:    units.NewQuantity(big.NewRat(1, 1), units.Terabytes),
+		expected: -1,
+	}} {
+		res, err := gib.Cmp(tc.other)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res != tc.expected {
+			t.Errorf("comparing %s and %s: expected %d, got %d", gib, tc.other, tc.expected, res)
+		}
+	}
+}
+
+func TestQuantityErrors(t *testing.T) {
+	gib := units.NewQuantity(big.NewRat(1, 1), units.Gibibytes)
+	celsius := units.NewQuantity(big.NewRat(1, 1), units.NewAffineUnit("celsius", "°C", "temperature", big.NewRat(1, 1), big.NewRat(27315, 100)))
+
+	if _, err := gib.Add(celsius); !errors.Is(err, units.ErrNonLinearUnit) {
+		t.Errorf("expected ErrNonLinearUnit, got %v", err)
+	}
+	if _, err := gib.Cmp(celsius); !errors.Is(err, units.ErrIncompatibleUnits) {
+		t.Errorf("expected ErrIncompatibleUnits, got %v", err)
+	}
+	if _, err := gib.Div(new(big.Rat)); !errors.Is(err, units.ErrDivisionByZero) {
+		t.Errorf("expected ErrDivisionByZero, got %v", err)
+	}
+}
+
+func TestDecimalDigits(t *testing.T) {
+	for _, tc := range []struct {
+		value  *big.Rat
+		digits int
+		ok     bool
+	}{
+		{value: big.NewRat(42, 1), digits: 0, ok: true},
+		{value: big.NewRat(9, 8), digits: 3, ok: true},
+		{value: big.NewRat(-1, 20), digits: 2, ok: true},
+		{value: big.NewRat(1, 3), ok: false},
+	} {
+		digits, ok := units.DecimalDigits(tc.value)
+		if ok != tc.ok || ok && digits != tc.digits {
+			t.Errorf("%s: expected %d digits (%t), got %d (%t)", tc.value.RatString(), tc.digits, tc.ok, digits, ok)
+		}
+	}
+}
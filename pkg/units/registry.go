@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Category is a set of units of one quantity, which are converted to each other through the base unit.
+type Category struct {
+	Name  string
+	Base  Unit
+	Units []Unit
+}
+
+// Dimension returns the dimension shared by all units of c.
+func (c Category) Dimension() Dimension {
+	return c.Base.Dimension
+}
+
+// Registry holds categories of units and looks units up by name, symbol or alias.
+type Registry struct {
+	categories []Category
+	keys       []unitKey
+	exact      map[string]Unit
+	folded     map[string][]unitKey
+}
+
+type unitKey struct {
+	key  string
+	unit Unit
+}
+
+// NewRegistry creates a registry of categories.
+// It fails if names, symbols or aliases of units collide.
+func NewRegistry(categories ...Category) (*Registry, error) {
+	r := &Registry{
+		exact:  map[string]Unit{},
+		folded: map[string][]unitKey{},
+	}
+
+	for _, category := range categories {
+		if err := r.Register(category); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// MustNewRegistry is like NewRegistry but panics on error.
+func MustNewRegistry(categories ...Category) *Registry {
+	r, err := NewRegistry(categories...)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Register adds category to the registry.
+func (r *Registry) Register(category Category) error {
+	for _, c := range r.categories {
+		if c.Name == category.Name {
+			return fmt.Errorf("category %q is already registered", category.Name)
+		}
+	}
+
+	seen := map[string]string{}
+	for _, unit := range category.Units {
+		if unit.Category != category.Name {
+			return fmt.Errorf("unit %q belongs to category %q, not %q", unit.Name, unit.Category, category.Name)
+		}
+		if unit.Dimension != category.Dimension() {
+			return fmt.Errorf("unit %q of %s doesn't match %s of category %q", unit.Name, unit.Dimension, category.Dimension(), category.Name)
+		}
+
+		for _, key := range unitKeys(unit) {
+			if existing, ok := r.exact[key]; ok {
+				return fmt.Errorf("%q of unit %q is already used by unit %q", key, unit.Name, existing.Name)
+			}
+			if existing, ok := seen[key]; ok && existing != unit.Name {
+				return fmt.Errorf("%q of unit %q is already used by unit %q", key, unit.Name, existing)
+			}
+			seen[key] = unit.Name
+		}
+	}
+
+	for _, unit := range category.Units {
+		r.addKeys(unit)
+	}
+	r.categories = append(r.categories, category)
+
+	return nil
+}
+
+// RegisterUnit adds unit to its registered category, e.g. a unit defined by a user.
+// It fails if the category is not registered, or the name, symbol or aliases of unit are already used.
+func (r *Registry) RegisterUnit(unit Unit) error {
+	i := slices.IndexFunc(r.categories, func(c Category) bool { return c.Name == unit.Category })
+	if i < 0 {
+		return fmt.Errorf("category %q of unit %q is not registered", unit.Category, unit.Name)
+	}
+
+	category := r.categories[i]
+	if unit.Dimension != category.Dimension() {
+		return fmt.Errorf("unit %q of %s doesn't match %s of category %q", unit.Name, unit.Dimension, category.Dimension(), category.Name)
+	}
+	for _, key := range unitKeys(unit) {
+		if existing, ok := r.exact[key]; ok {
+			return fmt.Errorf("%q of unit %q is already used by unit %q", key, unit.Name, existing.Name)
+		}
+	}
+
+	r.addKeys(unit)
+	// Units of categories may be shared with other registries, so they are never appended in place.
+	category.Units = append(slices.Clip(category.Units), unit)
+	r.categories[i] = category
+
+	return nil
+}
+
+// addKeys makes unit looked up by its name, symbol and aliases.
+func (r *Registry) addKeys(unit Unit) {
+	for i, key := range unitKeys(unit) {
+		r.exact[key] = unit
+		r.keys = append(r.keys, unitKey{key: key, unit: unit})
+
+		// The first key is the name, which is always matched case-insensitively.
+		if i == 0 || !unit.CaseSensitive {
+			folded := strings.ToLower(key)
+			r.folded[folded] = append(r.folded[folded], unitKey{key: key, unit: unit})
+		}
+	}
+}
+
+// unitKeys returns the name, symbol and aliases of unit in this order.
+func unitKeys(unit Unit) []string {
+	keys := []string{unit.Name}
+	if unit.Symbol != "" && unit.Symbol != unit.Name {
+		keys = append(keys, unit.Symbol)
+	}
+	for _, alias := range unit.Aliases {
+		if alias != unit.Name && alias != unit.Symbol {
+			keys = append(keys, alias)
+		}
+	}
+
+	return keys
+}
+
+// Lookup returns a unit by its name, symbol or alias.
+// If no unit is found, it returns an *UnknownUnitError suggesting similar names.
+func (r *Registry) Lookup(name string) (Unit, error) {
+	if unit, ok := r.exact[name]; ok {
+		return unit, nil
+	}
+
+	matches := r.folded[strings.ToLower(name)]
+	if len(matches) > 0 {
+		unit := matches[0].unit
+		ambiguous := false
+		for _, m := range matches[1:] {
+			if m.unit.Name != unit.Name {
+				ambiguous = true
+			}
+		}
+		if !ambiguous {
+			return unit, nil
+		}
+
+		var suggestions []string
+		for _, m := range matches {
+			suggestions = append(suggestions, m.key)
+		}
+
+		return Unit{}, &UnknownUnitError{Name: name, Suggestions: suggestions}
+	}
+
+	return Unit{}, &UnknownUnitError{Name: name, Suggestions: suggest(name, r.keys)}
+}
+
+// Category returns a category by its name.
+func (r *Registry) Category(name string) (Category, bool) {
+	for _, category := range r.categories {
+		if category.Name == name {
+			return category, true
+		}
+	}
+
+	return Category{}, false
+}
+
+// UnitCategory returns the category of unit. Compound units belong to the first registered category
+// of the same dimension, e.g. GiB·s/s to data_size.
+func (r *Registry) UnitCategory(unit Unit) (Category, bool) {
+	if unit.Category != "" {
+		return r.Category(unit.Category)
+	}
+
+	for _, category := range r.categories {
+		if Compatible(unit, category.Base) {
+			return category, true
+		}
+	}
+
+	return Category{}, false
+}
+
+// BaseUnit returns the base unit of the category of unit. Compound units without a category return
+// the product of base units of categories of their factors, e.g. B/s for MiB/s, which is a unit of the same dimension.
+func (r *Registry) BaseUnit(unit Unit) (Unit, error) {
+	if category, ok := r.UnitCategory(unit); ok {
+		return category.Base, nil
+	}
+	// Dimensionless compound units, e.g. GiB/GiB, have no factors left.
+	if !unit.IsCompound() && unit.Dimension != (Dimension{}) {
+		return Unit{}, fmt.Errorf("%w: %s belongs to no category", ErrUnknownUnit, unit.Name)
+	}
+
+	var powers []Power
+	for _, f := range unit.factors {
+		factor, err := r.Lookup(f.symbol)
+		if err != nil {
+			return Unit{}, err
+		}
+		category, ok := r.UnitCategory(factor)
+		if !ok {
+			return Unit{}, fmt.Errorf("%w: %s belongs to no category", ErrUnknownUnit, factor.Name)
+		}
+		powers = append(powers, Power{Unit: category.Base, Exponent: f.exponent})
+	}
+
+	return Compose(powers...)
+}
+
+// Categories returns all registered categories in order of registration.
+func (r *Registry) Categories() []Category {
+	return append([]Category(nil), r.categories...)
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+func TestRegistryLookup(t *testing.T) {
+	for _, name := range []string{"gibibytes", "GiB", "gibibyte", "Gibibytes", "gib", "GIB"} {
+		unit, err := units.Default.Lookup(name)
+		if err != nil {
+			t.Errorf("looking up %q: %s", name, err)
+			continue
+		}
+		if unit.Name != units.Gibibytes.Name {
+			t.Errorf("expected %q for %q, got %q", units.Gibibytes.Name, name, unit.Name)
+		}
+	}
+}
+
+func TestRegistryLookup_Unknown(t *testing.T) {
+	_, err := units.Default.Lookup("gibibites")
+	if !errors.Is(err, units.ErrUnknownUnit) {
+		t.Fatalf("expected ErrUnknownUnit, got %v", err)
+	}
+	if expected := `unknown unit "gibibites", did you mean "gibibits", "gibibytes" or "kibibits"?`; err.Error() != expected {
+		t.Errorf("expected %s, got %s", expected, err)
+	}
+
+	_, err = units.Default.Lookup("parsecs")
+	if expected := `unknown unit "parsecs"`; err == nil || err.Error() != expected {
+		t.Errorf("expected %s, got %v", expected, err)
+	}
+}
+
+func TestRegistryLookup_CaseSensitive(t *testing.T) {
+	category := "bit_rate"
+	dimension := units.Dimension{units.BaseInformation: 1, units.BaseTime: -1}
+	megabits := units.Unit{
+		Name: "megabits", Symbol: "Mb", CaseSensitive: true,
+		Category: category, Dimension: dimension, Kind: units.KindLinear, Scale: big.NewRat(1000000, 1),
+	}
+	millibits := units.Unit{
+		Name: "millibits", Symbol: "mb", CaseSensitive: true,
+		Category: category, Dimension: dimension, Kind: units.KindLinear, Scale: big.NewRat(1, 1000),
+	}
+	r := units.MustNewRegistry(units.Category{
+		Name:  category,
+		Base:  megabits,
+		Units: []units.Unit{megabits, millibits},
+	})
+
+	if unit, err := r.Lookup("mb"); err != nil || unit.Name != millibits.Name {
+		t.Errorf("expected %q, got %q (%v)", millibits.Name, unit.Name, err)
+	}
+	if _, err := r.Lookup("MB"); !errors.Is(err, units.ErrUnknownUnit) {
+		t.Errorf("expected ErrUnknownUnit for an ambiguous symbol, got %v", err)
+	}
+	if unit, err := r.Lookup("MegaBits"); err != nil || unit.Name != megabits.Name {
+		t.Errorf("expected %q, got %q (%v)", megabits.Name, unit.Name, err)
+	}
+}
+
+func TestRegistryCategory(t *testing.T) {
+	category, ok := units.Default.Category("data_size")
+	if !ok {
+		t.Fatal("category not found")
+	}
+	if category.Base.Name != units.Bytes.Name {
+		t.Errorf("expected base unit %q, got %q", units.Bytes.Name, category.Base.Name)
+	}
+	if category.Dimension() != units.DimensionInformation {
+		t.Errorf("expected %s, got %s", units.DimensionInformation, category.Dimension())
+	}
+}
+
+func TestRegistryUnitCategory(t *testing.T) {
+	for _, tc := range []struct {
+		unit     string
+		expected string
+	}{
+		{unit: "Gibit", expected: "data_size_bits"},
+		{unit: "GiB*s/s", expected: "data_size"},
+		{unit: "km", expected: "length"},
+	} {
+		unit, err := units.Default.Parse(tc.unit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		category, ok := units.Default.UnitCategory(unit)
+		if !ok {
+			t.Fatalf("category of %s not found", tc.unit)
+		}
+		if category.Name != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, category.Name)
+		}
+	}
+
+	unit, err := units.Default.Parse("m/s")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := units.Default.UnitCategory(unit); ok {
+		t.Error("expected no category of velocity")
+	}
+}
+
+func TestRegistryBaseUnit(t *testing.T) {
+	for _, tc := range []struct {
+		unit     string
+		expected string
+	}{
+		{unit: "GiB", expected: "bytes"},
+		{unit: "GiB*s/s", expected: "bytes"},
+		{unit: "MiB/s", expected: "B/s"},
+		{unit: "km/h", expected: "m/s"},
+		{unit: "GiB/GiB", expected: "1"},
+	} {
+		unit, err := units.Default.Parse(tc.unit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		base, err := units.Default.BaseUnit(unit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if base.Name != tc.expected {
+			t.Errorf("%s: expected %s, got %s", tc.unit, tc.expected, base.Name)
+		}
+		if !units.Compatible(unit, base) {
+			t.Errorf("%s: expected %s to be of the same dimension", tc.unit, base.Name)
+		}
+	}
+}
+
+func TestRegistryCollisions(t *testing.T) {
+	blocks := units.NewLinearUnit("blocks", "GiB", "storage", big.NewRat(4096, 1))
+	blocks.Dimension = units.DimensionInformation
+	if _, err := units.NewRegistry(units.DataSize, units.Category{
+		Name:  "storage",
+		Base:  blocks,
+		Units: []units.Unit{blocks},
+	}); err == nil {
+		t.Error("expected an error registering a unit with a symbol of another unit")
+	}
+
+	if _, err := units.NewRegistry(units.DataSize, units.DataSize); err == nil {
+		t.Error("expected an error registering a category twice")
+	}
+
+	seconds := units.NewLinearUnit("seconds", "s", "data_size", big.NewRat(1, 1))
+	seconds.Dimension = units.DimensionTime
+	if _, err := units.NewRegistry(units.Category{
+		Name:  "data_size",
+		Base:  units.Bytes,
+		Units: []units.Unit{units.Bytes, seconds},
+	}); err == nil {
+		t.Error("expected an error registering a unit of another dimension")
+	}
+}
+
+func TestRegistryRegisterUnit(t *testing.T) {
+	r := units.MustNewRegistry(units.Default.Categories()...)
+
+	slots, err := units.DeriveUnit("slots", "slot", big.NewRat(8, 1), units.Gibibytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = r.RegisterUnit(slots); err != nil {
+		t.Fatal(err)
+	}
+
+	unit, err := r.Lookup("slot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := units.Convert(big.NewRat(2, 1), unit, units.Gibibytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Cmp(big.NewRat(16, 1)) != 0 {
+		t.Errorf("expected 16, got %s", res.RatString())
+	}
+
+	category, _ := r.Category("data_size")
+	if last := category.Units[len(category.Units)-1]; last.Name != "slots" {
+		t.Errorf("expected slots in category, got %q", last.Name)
+	}
+	if _, err = units.Default.Lookup("slots"); err == nil {
+		t.Error("expected the default registry not to change")
+	}
+	if defaultCategory, _ := units.Default.Category("data_size"); len(defaultCategory.Units) != len(category.Units)-1 {
+		t.Error("expected units of the default category not to change")
+	}
+
+	for name, unit := range map[string]units.Unit{
+		"symbol of another unit": units.NewLinearUnit("gibs", "GiB", "data_size", big.NewRat(1, 1)),
+		"unknown category":       units.NewLinearUnit("credits", "cr", "money", big.NewRat(1, 1)),
+		"another dimension":      units.NewLinearUnit("ticks", "tick", "data_size", big.NewRat(1, 1)),
+	} {
+		if name != "another dimension" {
+			unit.Dimension = units.DimensionInformation
+		}
+		if err = r.RegisterUnit(unit); err == nil {
+			t.Errorf("expected an error registering a unit with %s", name)
+		}
+	}
+}
+
+func TestDeriveUnit_Errors(t *testing.T) {
+	gibPerSecond, _ := units.Div(units.Gibibytes, units.Seconds)
+
+	for name, unit := range map[string]units.Unit{
+		"non-linear": units.Celsius,
+		"compound":   gibPerSecond,
+	} {
+		if _, err := units.DeriveUnit("custom", "", big.NewRat(1, 1), unit); err == nil {
+			t.Errorf("expected an error deriving a unit from a %s unit", name)
+		}
+	}
+
+	if _, err := units.DeriveUnit("custom", "", big.NewRat(-1, 1), units.Bytes); err == nil {
+		t.Error("expected an error deriving a unit with a negative factor")
+	}
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units
+
+import (
+	"math/big"
+)
+
+// RoundingMode defines how a value is rounded to a number of decimal places.
+type RoundingMode string
+
+const (
+	// RoundHalfEven rounds to the nearest neighbor, or to the even neighbor if both are equidistant.
+	RoundHalfEven RoundingMode = "half_even"
+	// RoundHalfUp rounds to the nearest neighbor, or away from zero if both neighbors are equidistant.
+	RoundHalfUp RoundingMode = "half_up"
+	// RoundDown rounds toward zero.
+	RoundDown RoundingMode = "down"
+	// RoundUp rounds away from zero.
+	RoundUp RoundingMode = "up"
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor RoundingMode = "floor"
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling RoundingMode = "ceiling"
+)
+
+// RoundingModes are all rounding modes.
+var RoundingModes = []RoundingMode{RoundHalfEven, RoundHalfUp, RoundDown, RoundUp, RoundFloor, RoundCeiling}
+
+// Rounding rounds values to a number of decimal places.
+type Rounding struct {
+	// Places is a number of decimal places. Negative numbers round to tens, hundreds and so on.
+	Places int
+	Mode   RoundingMode
+}
+
+// Round returns value rounded to r.Places decimal places.
+func (r Rounding) Round(value *big.Rat) *big.Rat {
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(r.Places))), nil))
+	if r.Places < 0 {
+		scale.Inv(scale)
+	}
+
+	scaled := new(big.Rat).Mul(value, scale)
+	// The denominator is positive, so the Euclidean quotient is the floor of the scaled value.
+	res, remainder := new(big.Int).DivMod(scaled.Num(), scaled.Denom(), new(big.Int))
+	if remainder.Sign() != 0 {
+		// half is the sign of the distance from the floor minus one half.
+		half := new(big.Int).Lsh(remainder, 1).Cmp(scaled.Denom())
+
+		var up bool
+		switch r.Mode {
+		case RoundHalfUp:
+			up = half > 0 || half == 0 && scaled.Sign() > 0
+		case RoundDown:
+			up = scaled.Sign() < 0
+		case RoundUp:
+			up = scaled.Sign() > 0
+		case RoundFloor:
+			up = false
+		case RoundCeiling:
+			up = true
+		default:
+			up = half > 0 || half == 0 && res.Bit(0) == 1
+		}
+		if up {
+			res.Add(res, big.NewInt(1))
+		}
+	}
+
+	return new(big.Rat).Quo(new(big.Rat).SetInt(res), scale)
+}
+
+// Round returns q with its value rounded with r.
+func (q Quantity) Round(r Rounding) Quantity {
+	return NewQuantity(r.Round(q.Value), q.Unit)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+func TestRoundingRound(t *testing.T) {
+	values := []string{"2.5", "-2.5", "1.005", "3.14159", "-3.14159", "7"}
+
+	for _, tc := range []struct {
+		rounding units.Rounding
+		expected []string
+	}{
+		{rounding: units.Rounding{Places: 0, Mode: units.RoundHalfEven}, expected: []string{"2", "-2", "1", "3", "-3", "7"}},
+		{rounding: units.Rounding{Places: 0, Mode: units.RoundHalfUp}, expected: []string{"3", "-3", "1", "3", "-3", "7"}},
+		{rounding: units.Rounding{Places: 2, Mode: units.RoundHalfUp}, expected: []string{"2.5", "-2.5", "1.01", "3.14", "-3.14", "7"}},
+		{rounding: units.Rounding{Places: 2, Mode: units.RoundHalfEven}, expected: []string{"2.5", "-2.5", "1", "3.14", "-3.14", "7"}},
+		{rounding: units.Rounding{Places: 1, Mode: units.RoundDown}, expected: []string{"2.5", "-2.5", "1", "3.1", "-3.1", "7"}},
+		{rounding: units.Rounding{Places: 1, Mode: units.RoundUp}, expected: []string{"2.5", "-2.5", "1.1", "3.2", "-3.2", "7"}},
+		{rounding: units.Rounding{Places: 1, Mode: units.RoundFloor}, expected: []string{"2.5", "-2.5", "1", "3.1", "-3.2", "7"}},
+		{rounding: units.Rounding{Places: 1, Mode: units.RoundCeiling}, expected: []string{"2.5", "-2.5", "1.1", "3.2", "-3.1", "7"}},
+		{rounding: units.Rounding{Places: -1, Mode: units.RoundHalfUp}, expected: []string{"0", "0", "0", "0", "0", "10"}},
+	} {
+		t.Run(string(tc.rounding.Mode), func(t *testing.T) {
+			for i, value := range values {
+				r, _ := new(big.Rat).SetString(value)
+				res := tc.rounding.Round(r)
+				if expected, _ := new(big.Rat).SetString(tc.expected[i]); res.Cmp(expected) != 0 {
+					t.Errorf("%s rounded to %d places: expected %s, got %s", value, tc.rounding.Places, tc.expected[i], res.RatString())
+				}
+			}
+		})
+	}
+}
+
+func TestUnitSystemHumanize(t *testing.T) {
+	for _, tc := range []struct {
+		system   units.UnitSystem
+		quantity units.Quantity
+		expected string
+	}{
+		{system: units.IEC, quantity: units.NewQuantity(big.NewRat(1536, 1), units.Mebibytes), expected: "1.5 GiB"},
+		{system: units.SI, quantity: units.NewQuantity(big.NewRat(1536, 1), units.Mebibytes), expected: "1.610612736 GB"},
+		{system: units.IEC, quantity: units.NewQuantity(big.NewRat(1023, 1), units.Bytes), expected: "1023 B"},
+		{system: units.IEC, quantity: units.NewQuantity(big.NewRat(1, 2), units.Bytes), expected: "0.5 B"},
+		{system: units.SI, quantity: units.NewQuantity(big.NewRat(-2000, 1), units.Bytes), expected: "-2 kB"},
+		{system: units.IEC, quantity: units.NewQuantity(big.NewRat(4096, 1), units.Pebibytes), expected: "4096 PiB"},
+	} {
+		t.Run(tc.expected, func(t *testing.T) {
+			res, err := tc.system.Humanize(tc.quantity)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.String() != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// UnitSystem is a series of units of one quantity in ascending order, which quantities are formatted with.
+type UnitSystem struct {
+	Name  string
+	Units []Unit
+}
+
+var (
+	// IEC is the system of data sizes in powers of 1024, e.g. GiB.
+	IEC = UnitSystem{
+		Name:  "iec",
+		Units: []Unit{Bytes, Kibibytes, Mebibytes, Gibibytes, Tebibytes, Pebibytes},
+	}
+	// SI is the system of data sizes in powers of 1000, e.g. GB.
+	SI = UnitSystem{
+		Name:  "si",
+		Units: []Unit{Bytes, Kilobytes, Megabytes, Gigabytes, Terabytes, Petabytes},
+	}
+
+	// UnitSystems are all unit systems.
+	UnitSystems = []UnitSystem{IEC, SI}
+)
+
+// Humanize converts q to the largest unit of s, in which its absolute value is at least 1,
+// or to the smallest unit of s, e.g. 1536 MiB to 1.5 GiB.
+func (s UnitSystem) Humanize(q Quantity) (Quantity, error) {
+	if len(s.Units) == 0 {
+		return Quantity{}, fmt.Errorf("unit system %q has no units", s.Name)
+	}
+
+	one := big.NewRat(1, 1)
+	for i := len(s.Units) - 1; i > 0; i-- {
+		res, err := q.In(s.Units[i])
+		if err != nil {
+			return Quantity{}, err
+		}
+		if new(big.Rat).Abs(res.Value).Cmp(one) >= 0 {
+			return res, nil
+		}
+	}
+
+	return q.In(s.Units[0])
+}
@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+// Package units is a unit conversion engine independent of Terraform.
+// Conversions are computed on exact rational numbers whenever units allow it.
+package units
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// ErrIncompatibleUnits is returned when units of different dimensions or categories are combined.
+var ErrIncompatibleUnits = errors.New("incompatible units")
+
+// ErrNotFinite is returned when a conversion of a logarithmic unit is out of the float64 range.
+var ErrNotFinite = errors.New("result is out of the float64 range")
+
+// DefaultPrecision is the minimal precision in bits of big.Float conversion results.
+const DefaultPrecision uint = 53
+
+// Kind defines how a unit relates to the base unit of its category.
+type Kind int
+
+const (
+	// KindLinear is base = value * Scale.
+	KindLinear Kind = iota
+	// KindAffine is base = value * Scale + Offset.
+	KindAffine
+	// KindLogarithmic is value = Factor * log10(base / Reference).
+	KindLogarithmic
+)
+
+// Unit is a measurement unit convertible to the base unit of its category.
+//
+// Linear units of every category of a dimension are scaled relative to the same coherent unit,
+// e.g. both bytes and bits are relative to bytes, so they are convertible to each other.
+//
+// A unit is looked up by its name, symbol or aliases. Names are matched case-insensitively.
+// Symbols and aliases are matched case-insensitively too, unless CaseSensitive is set,
+// e.g. to tell millibits from megabits.
+type Unit struct {
+	Name          string
+	Symbol        string
+	Aliases       []string
+	CaseSensitive bool
+
+	Category  string
+	Dimension Dimension
+	Kind      Kind
+
+	Scale  *big.Rat
+	Offset *big.Rat
+
+	Reference *big.Rat
+	Factor    *big.Rat
+
+	// factors are set on compound units only.
+	factors []factor
+}
+
+// NewLinearUnit creates a unit which is scale times the base unit.
+func NewLinearUnit(name, symbol, category string, scale *big.Rat) Unit {
+	return Unit{
+		Name:     name,
+		Symbol:   symbol,
+		Category: category,
+		Kind:     KindLinear,
+		Scale:    scale,
+	}
+}
+
+// DeriveUnit creates a linear unit equal to factor of unit in the same category, e.g. slots of 8 GiB.
+func DeriveUnit(name, symbol string, factor *big.Rat, unit Unit) (Unit, error) {
+	switch {
+	case unit.Kind != KindLinear:
+		return Unit{}, fmt.Errorf("%w: cannot derive a unit from %s", ErrNonLinearUnit, unit.Name)
+	case unit.IsCompound():
+		return Unit{}, fmt.Errorf("cannot derive a unit from compound unit %s", unit.Name)
+	case factor.Sign() <= 0:
+		return Unit{}, fmt.Errorf("factor of unit %q must be positive, got: %s", name, factor.RatString())
+	}
+
+	derived := NewLinearUnit(name, symbol, unit.Category, new(big.Rat).Mul(factor, unit.Scale))
+	derived.Dimension = unit.Dimension
+
+	return derived, nil
+}
+
+// NewAffineUnit creates a unit defined as base = value * scale + offset, e.g. degrees Celsius.
+func NewAffineUnit(name, symbol, category string, scale, offset *big.Rat) Unit {
+	return Unit{
+		Name:     name,
+		Symbol:   symbol,
+		Category: category,
+		Kind:     KindAffine,
+		Scale:    scale,
+		Offset:   offset,
+	}
+}
+
+// NewLogarithmicUnit creates a dB-style unit defined as value = factor * log10(base / reference).
+func NewLogarithmicUnit(name, symbol, category string, reference, factor *big.Rat) Unit {
+	return Unit{
+		Name:      name,
+		Symbol:    symbol,
+		Category:  category,
+		Kind:      KindLogarithmic,
+		Reference: reference,
+		Factor:    factor,
+	}
+}
+
+// ToBase converts value in u to the coherent unit of its dimension, e.g. bytes or seconds.
+// Logarithmic units are converted with float64 precision, other kinds are converted exactly.
+// Results of logarithmic units out of the float64 range are ErrNotFinite errors.
+func (u Unit) ToBase(value *big.Rat) (*big.Rat, error) {
+	switch u.Kind {
+	case KindAffine:
+		return new(big.Rat).Add(new(big.Rat).Mul(value, u.Scale), u.Offset), nil
+	case KindLogarithmic:
+		v, _ := value.Float64()
+		factor, _ := u.Factor.Float64()
+		reference, _ := u.Reference.Float64()
+
+		return ratFromFloat64(reference * math.Pow(10, v/factor))
+	default:
+		return new(big.Rat).Mul(value, u.Scale), nil
+	}
+}
+
+// FromBase converts value in the coherent unit of the dimension of u to u.
+// Results of logarithmic units out of the float64 range are ErrNotFinite errors,
+// as well as non-positive values, which have no logarithm.
+func (u Unit) FromBase(value *big.Rat) (*big.Rat, error) {
+	switch u.Kind {
+	case KindAffine:
+		return new(big.Rat).Quo(new(big.Rat).Sub(value, u.Offset), u.Scale), nil
+	case KindLogarithmic:
+		if value.Sign() <= 0 {
+			return nil, fmt.Errorf("%w: logarithm of %s", ErrNotFinite, value.RatString())
+		}
+
+		ratio, _ := new(big.Rat).Quo(value, u.Reference).Float64()
+		factor, _ := u.Factor.Float64()
+
+		return ratFromFloat64(factor * math.Log10(ratio))
+	default:
+		return new(big.Rat).Quo(value, u.Scale), nil
+	}
+}
+
+// ToBaseFloat is like ToBase but operates on big.Float.
+// The result has the precision of value, but at least DefaultPrecision bits.
+func (u Unit) ToBaseFloat(value *big.Float) (*big.Float, error) {
+	if value.IsInf() {
+		return new(big.Float).Set(value), nil
+	}
+
+	r, _ := value.Rat(nil)
+	res, err := u.ToBase(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFloat(res, value.Prec()), nil
+}
+
+// FromBaseFloat is like FromBase but operates on big.Float.
+// The result has the precision of value, but at least DefaultPrecision bits.
+func (u Unit) FromBaseFloat(value *big.Float) (*big.Float, error) {
+	if value.IsInf() {
+		return new(big.Float).Set(value), nil
+	}
+
+	r, _ := value.Rat(nil)
+	res, err := u.FromBase(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFloat(res, value.Prec()), nil
+}
+
+// Convert converts value from one unit to another unit of the same dimension.
+func Convert(value *big.Rat, from, to Unit) (*big.Rat, error) {
+	if !Compatible(from, to) {
+		return nil, fmt.Errorf("%w: cannot convert %s of %s to %s of %s", ErrIncompatibleUnits, from.Name, from.quantity(), to.Name, to.quantity())
+	}
+
+	base, err := from.ToBase(value)
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert %s to %s: %w", from.Name, to.Name, err)
+	}
+	res, err := to.FromBase(base)
+	if err != nil {
+		return nil, fmt.Errorf("cannot convert %s to %s: %w", from.Name, to.Name, err)
+	}
+
+	return res, nil
+}
+
+// Compatible reports whether values are convertible between units a and b.
+// Units of the same dimension are compatible, except dimensionless units of different categories, e.g. percents and decibels.
+func Compatible(a, b Unit) bool {
+	if a.Dimension != b.Dimension {
+		return false
+	}
+
+	return a.Dimension != Dimensionless || a.Category == b.Category
+}
+
+// quantity describes what u measures in error messages.
+func (u Unit) quantity() string {
+	if u.Category != "" && u.Dimension == Dimensionless {
+		return u.Category
+	}
+
+	return u.Dimension.String()
+}
+
+// ConvertFloat converts value from one unit to another unit of the same dimension.
+// The result has the precision of value, but at least DefaultPrecision bits.
+func ConvertFloat(value *big.Float, from, to Unit) (*big.Float, error) {
+	if value.IsInf() {
+		return new(big.Float).Set(value), nil
+	}
+
+	r, _ := value.Rat(nil)
+	res, err := Convert(r, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFloat(res, value.Prec()), nil
+}
+
+func newFloat(r *big.Rat, prec uint) *big.Float {
+	return new(big.Float).SetPrec(max(prec, DefaultPrecision)).SetRat(r)
+}
+
+// ratFromFloat64 returns f as an exact ratio. Infinite and NaN values are ErrNotFinite errors.
+func ratFromFloat64(f float64) (*big.Rat, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nil, fmt.Errorf("%w: %g", ErrNotFinite, f)
+	}
+
+	r, _ := new(big.Float).SetFloat64(f).Rat(nil)
+	return r, nil
+}
+
+func mustParseRat(s string) *big.Rat {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		panic(fmt.Sprintf("invalid rational number %q", s))
+	}
+
+	return r
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package units_test
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+func TestUnitKinds(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		unit  units.Unit
+		value *big.Rat
+		base  *big.Rat
+	}{{
+		name:  "linear",
+		unit:  units.Kibibytes,
+		value: big.NewRat(4, 1),
+		base:  big.NewRat(4096, 1),
+	}, {
+		name:  "affine",
+		unit:  units.NewAffineUnit("celsius", "°C", "temperature", big.NewRat(1, 1), big.NewRat(27315, 100)),
+		value: big.NewRat(-27315, 100),
+		base:  big.NewRat(0, 1),
+	}, {
+		name:  "logarithmic",
+		unit:  units.DecibelMilliwatts,
+		value: big.NewRat(30, 1),
+		base:  big.NewRat(1, 1),
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			if base, err := tc.unit.ToBase(tc.value); err != nil || base.Cmp(tc.base) != 0 {
+				t.Errorf("expected %s in base units, got %v (%v)", tc.base.RatString(), base, err)
+			}
+			if value, err := tc.unit.FromBase(tc.base); err != nil || value.Cmp(tc.value) != 0 {
+				t.Errorf("expected %s, got %v (%v)", tc.value.RatString(), value, err)
+			}
+		})
+	}
+}
+
+func TestUnitKinds_NotFinite(t *testing.T) {
+	unit, err := units.Default.Lookup("dBm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = unit.ToBase(big.NewRat(4000, 1)); !errors.Is(err, units.ErrNotFinite) {
+		t.Errorf("expected %v, got %v", units.ErrNotFinite, err)
+	}
+	if _, err = units.Convert(big.NewRat(4000, 1), unit, units.Milliwatts); !errors.Is(err, units.ErrNotFinite) {
+		t.Errorf("expected %v, got %v", units.ErrNotFinite, err)
+	}
+
+	huge := new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 1100))
+	if _, err = unit.FromBase(huge); !errors.Is(err, units.ErrNotFinite) {
+		t.Errorf("expected %v, got %v", units.ErrNotFinite, err)
+	}
+	if _, err = unit.ToBaseFloat(big.NewFloat(4000)); !errors.Is(err, units.ErrNotFinite) {
+		t.Errorf("expected %v, got %v", units.ErrNotFinite, err)
+	}
+
+	// Non-positive values have no logarithm.
+	for _, value := range []*big.Rat{big.NewRat(0, 1), big.NewRat(-1, 1)} {
+		if _, err = units.Convert(value, units.Watts, unit); !errors.Is(err, units.ErrNotFinite) {
+			t.Errorf("expected %v, got %v", units.ErrNotFinite, err)
+		}
+	}
+	if _, err = units.Convert(big.NewRat(0, 1), units.Watts, unit); err.Error() != "cannot convert watts to decibel-milliwatts: result is out of the float64 range: logarithm of 0" {
+		t.Errorf("unexpected error %q", err)
+	}
+}
+
+func TestConvert_Logarithmic(t *testing.T) {
+	// 30 dBm is 1 W, and 0 dBW is 1 W as well.
+	res, err := units.Convert(big.NewRat(30, 1), units.DecibelMilliwatts, units.DecibelWatts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Sign() != 0 {
+		t.Errorf("expected 0, got %s", res.RatString())
+	}
+
+	res, err = units.Convert(big.NewRat(3, 2), units.Kilowatts, units.DecibelWatts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, _ := res.Float64(); math.Abs(f-31.760912590556813) > 1e-12 {
+		t.Errorf("expected 31.760912590556813, got %g", f)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	res, err := units.Convert(big.NewRat(1, 1), units.Pebibytes, units.Terabytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := big.NewRat(1125899906842624, 1000000000000); res.Cmp(expected) != 0 {
+		t.Errorf("expected %s, got %s", expected.RatString(), res.RatString())
+	}
+
+	celsius := units.NewAffineUnit("celsius", "°C", "temperature", big.NewRat(1, 1), big.NewRat(27315, 100))
+	if _, err = units.Convert(big.NewRat(1, 1), units.Bytes, celsius); err == nil {
+		t.Error("expected an error converting units of different categories")
+	}
+}
+
+func TestConvertFloat(t *testing.T) {
+	res, err := units.ConvertFloat(big.NewFloat(1e15), units.Bytes, units.Gibibytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := res.Text('f', -1); s != "931322.5746154785" {
+		t.Errorf("expected 931322.5746154785, got %s", s)
+	}
+	if res.Prec() != units.DefaultPrecision {
+		t.Errorf("expected precision of %d bits, got %d", units.DefaultPrecision, res.Prec())
+	}
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unittypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+var (
+	_ basetypes.StringTypable                    = DataSizeStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = DataSizeString{}
+	_ xattr.ValidateableAttribute                = DataSizeString{}
+)
+
+// DataSizeStringType is a string type of data sizes, e.g. "1.5GiB", "1024 Mi" or "8 Gibit".
+type DataSizeStringType struct {
+	basetypes.StringType
+}
+
+func (t DataSizeStringType) String() string {
+	return "unittypes.DataSizeStringType"
+}
+
+func (t DataSizeStringType) ValueType(_ context.Context) attr.Value {
+	return DataSizeString{}
+}
+
+func (t DataSizeStringType) Equal(o attr.Type) bool {
+	other, ok := o.(DataSizeStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t DataSizeStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DataSizeString{StringValue: in}, nil
+}
+
+func (t DataSizeStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// DataSizeString is a string value of DataSizeStringType.
+type DataSizeString struct {
+	basetypes.StringValue
+}
+
+// NewDataSizeStringNull creates a null DataSizeString.
+func NewDataSizeStringNull() DataSizeString {
+	return DataSizeString{StringValue: basetypes.NewStringNull()}
+}
+
+// NewDataSizeStringUnknown creates an unknown DataSizeString.
+func NewDataSizeStringUnknown() DataSizeString {
+	return DataSizeString{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewDataSizeStringValue creates a known DataSizeString of value.
+func NewDataSizeStringValue(value string) DataSizeString {
+	return DataSizeString{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v DataSizeString) Type(_ context.Context) attr.Type {
+	return DataSizeStringType{}
+}
+
+func (v DataSizeString) Equal(o attr.Value) bool {
+	other, ok := o.(DataSizeString)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether v and newValuable describe the same data size, e.g. "1Gi" and "1024Mi".
+func (v DataSizeString) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(DataSizeString)
+	if !ok {
+		return false, semanticEqualityError(v, newValuable)
+	}
+
+	return equivalent(v.ValueString(), newValue.ValueString(), units.ParseDataSize), nil
+}
+
+func (v DataSizeString) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	resp.Diagnostics.Append(validate(v.StringValue, units.ParseDataSize, "Data Size", req.Path)...)
+}
+
+// Quantity parses v into a quantity of information.
+func (v DataSizeString) Quantity() (units.Quantity, diag.Diagnostics) {
+	return quantity(v.StringValue, units.ParseDataSize, "Data Size")
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unittypes_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+	"github.com/dstaroff/terraform-provider-units/pkg/unittypes"
+)
+
+func TestDataSizeStringSemanticEquals(t *testing.T) {
+	for _, tc := range []struct {
+		a, b     string
+		expected bool
+	}{
+		{a: "1Gi", b: "1024Mi", expected: true},
+		{a: "1 GiB", b: "1gibibytes", expected: true},
+		{a: "1GiB", b: "8 Gibit", expected: true},
+		{a: "1.5 GB", b: "1500MB", expected: true},
+		{a: "1GB", b: "1GiB", expected: false},
+		{a: "1Gi", b: "not a size", expected: false},
+		{a: "1 m", b: "1 m", expected: false},
+	} {
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			equal, diags := unittypes.NewDataSizeStringValue(tc.a).StringSemanticEquals(context.Background(), unittypes.NewDataSizeStringValue(tc.b))
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if equal != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, equal)
+			}
+		})
+	}
+}
+
+func TestDataSizeStringSemanticEquals_UnexpectedType(t *testing.T) {
+	_, diags := unittypes.NewDataSizeStringValue("1Gi").StringSemanticEquals(context.Background(), basetypes.NewStringValue("1024Mi"))
+	if !diags.HasError() {
+		t.Error("expected an error")
+	}
+}
+
+func TestDataSizeStringValidateAttribute(t *testing.T) {
+	for _, tc := range []struct {
+		value    unittypes.DataSizeString
+		expected bool
+	}{
+		{value: unittypes.NewDataSizeStringValue("20GiB"), expected: false},
+		{value: unittypes.NewDataSizeStringNull(), expected: false},
+		{value: unittypes.NewDataSizeStringUnknown(), expected: false},
+		{value: unittypes.NewDataSizeStringValue("20"), expected: true},
+		{value: unittypes.NewDataSizeStringValue("20 s"), expected: true},
+	} {
+		t.Run(tc.value.String(), func(t *testing.T) {
+			resp := &xattr.ValidateAttributeResponse{}
+			tc.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("size")}, resp)
+			if resp.Diagnostics.HasError() != tc.expected {
+				t.Errorf("expected error %t, got %v", tc.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDataSizeStringType_ValueFromTerraform(t *testing.T) {
+	value, err := unittypes.DataSizeStringType{}.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, "1Gi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !value.Equal(unittypes.NewDataSizeStringValue("1Gi")) {
+		t.Errorf("unexpected value %s", value)
+	}
+}
+
+func TestDataSizeStringQuantity(t *testing.T) {
+	q, diags := unittypes.NewDataSizeStringValue("1.5Gi").Quantity()
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	bytes, err := q.In(units.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Value.Cmp(big.NewRat(1610612736, 1)) != 0 {
+		t.Errorf("unexpected quantity %s", bytes)
+	}
+
+	if _, diags = unittypes.NewDataSizeStringNull().Quantity(); !diags.HasError() {
+		t.Error("expected an error")
+	}
+}
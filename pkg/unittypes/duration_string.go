@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unittypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+var (
+	_ basetypes.StringTypable                    = DurationStringType{}
+	_ basetypes.StringValuableWithSemanticEquals = DurationString{}
+	_ xattr.ValidateableAttribute                = DurationString{}
+)
+
+// DurationStringType is a string type of durations in Go syntax, e.g. "1h30m", or with units, e.g. "1.5 d" or "2 weeks".
+type DurationStringType struct {
+	basetypes.StringType
+}
+
+func (t DurationStringType) String() string {
+	return "unittypes.DurationStringType"
+}
+
+func (t DurationStringType) ValueType(_ context.Context) attr.Value {
+	return DurationString{}
+}
+
+func (t DurationStringType) Equal(o attr.Type) bool {
+	other, ok := o.(DurationStringType)
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t DurationStringType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return DurationString{StringValue: in}, nil
+}
+
+func (t DurationStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+// DurationString is a string value of DurationStringType.
+type DurationString struct {
+	basetypes.StringValue
+}
+
+// NewDurationStringNull creates a null DurationString.
+func NewDurationStringNull() DurationString {
+	return DurationString{StringValue: basetypes.NewStringNull()}
+}
+
+// NewDurationStringUnknown creates an unknown DurationString.
+func NewDurationStringUnknown() DurationString {
+	return DurationString{StringValue: basetypes.NewStringUnknown()}
+}
+
+// NewDurationStringValue creates a known DurationString of value.
+func NewDurationStringValue(value string) DurationString {
+	return DurationString{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v DurationString) Type(_ context.Context) attr.Type {
+	return DurationStringType{}
+}
+
+func (v DurationString) Equal(o attr.Value) bool {
+	other, ok := o.(DurationString)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether v and newValuable describe the same duration, e.g. "90m" and "1.5h".
+func (v DurationString) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	newValue, ok := newValuable.(DurationString)
+	if !ok {
+		return false, semanticEqualityError(v, newValuable)
+	}
+
+	return equivalent(v.ValueString(), newValue.ValueString(), units.ParseDuration), nil
+}
+
+func (v DurationString) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	resp.Diagnostics.Append(validate(v.StringValue, units.ParseDuration, "Duration", req.Path)...)
+}
+
+// Quantity parses v into a quantity of time.
+func (v DurationString) Quantity() (units.Quantity, diag.Diagnostics) {
+	return quantity(v.StringValue, units.ParseDuration, "Duration")
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unittypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/unittypes"
+)
+
+func TestDurationStringSemanticEquals(t *testing.T) {
+	for _, tc := range []struct {
+		a, b     string
+		expected bool
+	}{
+		{a: "90m", b: "1.5h", expected: true},
+		{a: "1h30m", b: "5400 s", expected: true},
+		{a: "1d", b: "24h", expected: true},
+		{a: "2 weeks", b: "14 days", expected: true},
+		{a: "15m", b: "15 min", expected: true},
+		{a: "1h", b: "1d", expected: false},
+		{a: "15m", b: "15 m", expected: true},
+		{a: "15m", b: "15 km", expected: false},
+	} {
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			equal, diags := unittypes.NewDurationStringValue(tc.a).StringSemanticEquals(context.Background(), unittypes.NewDurationStringValue(tc.b))
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if equal != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, equal)
+			}
+		})
+	}
+}
+
+func TestDurationStringValidateAttribute(t *testing.T) {
+	for _, tc := range []struct {
+		value    unittypes.DurationString
+		expected bool
+	}{
+		{value: unittypes.NewDurationStringValue("1h30m"), expected: false},
+		{value: unittypes.NewDurationStringValue("1.5 d"), expected: false},
+		{value: unittypes.NewDurationStringNull(), expected: false},
+		{value: unittypes.NewDurationStringValue("1.5"), expected: true},
+		{value: unittypes.NewDurationStringValue("1.5 GiB"), expected: true},
+	} {
+		t.Run(tc.value.String(), func(t *testing.T) {
+			resp := &xattr.ValidateAttributeResponse{}
+			tc.value.ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{Path: path.Root("timeout")}, resp)
+			if resp.Diagnostics.HasError() != tc.expected {
+				t.Errorf("expected error %t, got %v", tc.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
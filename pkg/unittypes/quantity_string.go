@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+// Package unittypes provides terraform-plugin-framework custom types for strings holding quantities, e.g. "1.5GiB".
+// Values of different units describing the same quantity are semantically equal,
+// so "1Gi" and "1024Mi" produce no difference in plan.
+package unittypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+type parseFunc func(string) (units.Quantity, error)
+
+// quantity parses value with parse. Null and unknown values are reported as errors.
+func quantity(value basetypes.StringValue, parse parseFunc, name string) (units.Quantity, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() || value.IsUnknown() {
+		diags.AddError(
+			fmt.Sprintf("%s String Value Is Not Known", name),
+			fmt.Sprintf("Cannot parse a null or unknown %s string value.", strings.ToLower(name)),
+		)
+
+		return units.Quantity{}, diags
+	}
+
+	q, err := parse(value.ValueString())
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Invalid %s String Value", name),
+			fmt.Sprintf("A string value was provided that is not a valid %s.\n\nGiven Value: %s\nError: %s", strings.ToLower(name), value.ValueString(), err),
+		)
+	}
+
+	return q, diags
+}
+
+// equivalent reports whether a and b describe the same quantity. Values, which are not parsed, are never equivalent.
+func equivalent(a, b string, parse parseFunc) bool {
+	qa, err := parse(a)
+	if err != nil {
+		return false
+	}
+	qb, err := parse(b)
+	if err != nil {
+		return false
+	}
+
+	cmp, err := qa.Cmp(qb)
+
+	return err == nil && cmp == 0
+}
+
+// validate reports an attribute error at path if value is known and is not parsed.
+func validate(value basetypes.StringValue, parse parseFunc, name string, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+
+	if _, err := parse(value.ValueString()); err != nil {
+		diags.AddAttributeError(
+			path,
+			fmt.Sprintf("Invalid %s String Value", name),
+			fmt.Sprintf("A string value was provided that is not a valid %s.\n\nGiven Value: %s\nError: %s", strings.ToLower(name), value.ValueString(), err),
+		)
+	}
+
+	return diags
+}
+
+func semanticEqualityError(expected, got any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		"Semantic Equality Check Error",
+		"An unexpected value type was received while performing semantic equality checks. "+
+			"Please report this to the provider developers.\n\n"+
+			fmt.Sprintf("Expected Value Type: %T\nGot Value Type: %T", expected, got),
+	)
+
+	return diags
+}
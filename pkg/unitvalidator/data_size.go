@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unitvalidator
+
+import (
+	"fmt"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+const dataSize = "data size"
+
+// DataSizeAtLeast returns a validator which ensures that a data size is at least min, e.g. "1GiB".
+// Number attributes are measured in bytes, unless another unit is set with In.
+// It panics if min is not a data size.
+func DataSizeAtLeast(min string) QuantityValidator {
+	limit := mustParse(units.ParseDataSize, min)
+
+	return newQuantityValidator(dataSize, units.ParseDataSize, units.Bytes, fmt.Sprintf("value must be at least %s", min), atLeast(limit))
+}
+
+// DataSizeAtMost returns a validator which ensures that a data size is at most max, e.g. "1TiB".
+// Number attributes are measured in bytes, unless another unit is set with In.
+// It panics if max is not a data size.
+func DataSizeAtMost(max string) QuantityValidator {
+	limit := mustParse(units.ParseDataSize, max)
+
+	return newQuantityValidator(dataSize, units.ParseDataSize, units.Bytes, fmt.Sprintf("value must be at most %s", max), atMost(limit))
+}
+
+// DataSizeBetween returns a validator which ensures that a data size is from min to max inclusive.
+// Number attributes are measured in bytes, unless another unit is set with In.
+// It panics if min or max is not a data size.
+func DataSizeBetween(min, max string) QuantityValidator {
+	lower, upper := mustParse(units.ParseDataSize, min), mustParse(units.ParseDataSize, max)
+
+	return newQuantityValidator(dataSize, units.ParseDataSize, units.Bytes, fmt.Sprintf("value must be between %s and %s", min, max), between(lower, upper))
+}
+
+// DataSizeMultipleOf returns a validator which ensures that a data size is an integer multiple of step, e.g. "4GiB".
+// Number attributes are measured in bytes, unless another unit is set with In.
+// It panics if step is not a data size.
+func DataSizeMultipleOf(step string) QuantityValidator {
+	limit := mustParse(units.ParseDataSize, step)
+
+	return newQuantityValidator(dataSize, units.ParseDataSize, units.Bytes, fmt.Sprintf("value must be a multiple of %s", step), multipleOf(limit))
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unitvalidator_test
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+	"github.com/dstaroff/terraform-provider-units/pkg/unitvalidator"
+)
+
+func TestDataSizeValidators_String(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		validator unitvalidator.QuantityValidator
+		value     types.String
+		expected  bool
+	}{
+		{name: "at least", validator: unitvalidator.DataSizeAtLeast("1GiB"), value: types.StringValue("1024Mi"), expected: false},
+		{name: "at least fails", validator: unitvalidator.DataSizeAtLeast("1GiB"), value: types.StringValue("1GB"), expected: true},
+		{name: "at most", validator: unitvalidator.DataSizeAtMost("1TiB"), value: types.StringValue("1TB"), expected: false},
+		{name: "at most fails", validator: unitvalidator.DataSizeAtMost("1TB"), value: types.StringValue("1TiB"), expected: true},
+		{name: "between", validator: unitvalidator.DataSizeBetween("1GiB", "2GiB"), value: types.StringValue("1.5 GiB"), expected: false},
+		{name: "between fails", validator: unitvalidator.DataSizeBetween("1GiB", "2GiB"), value: types.StringValue("3 GiB"), expected: true},
+		{name: "multiple of", validator: unitvalidator.DataSizeMultipleOf("4GiB"), value: types.StringValue("12288 MiB"), expected: false},
+		{name: "multiple of fails", validator: unitvalidator.DataSizeMultipleOf("4GiB"), value: types.StringValue("10 GiB"), expected: true},
+		{name: "not a data size", validator: unitvalidator.DataSizeAtLeast("1GiB"), value: types.StringValue("20 min"), expected: true},
+		{name: "malformed", validator: unitvalidator.DataSizeAtLeast("1GiB"), value: types.StringValue("GiB"), expected: true},
+		{name: "null", validator: unitvalidator.DataSizeAtLeast("1GiB"), value: types.StringNull(), expected: false},
+		{name: "unknown", validator: unitvalidator.DataSizeAtLeast("1GiB"), value: types.StringUnknown(), expected: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			tc.validator.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("size"), ConfigValue: tc.value}, resp)
+			if resp.Diagnostics.HasError() != tc.expected {
+				t.Errorf("expected error %t, got %v", tc.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDataSizeValidators_Number(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		validator unitvalidator.QuantityValidator
+		value     types.Number
+		expected  bool
+	}{
+		{name: "bytes", validator: unitvalidator.DataSizeAtLeast("1KiB"), value: types.NumberValue(big.NewFloat(1024)), expected: false},
+		{name: "bytes fails", validator: unitvalidator.DataSizeAtLeast("1KiB"), value: types.NumberValue(big.NewFloat(1000)), expected: true},
+		{name: "gibibytes", validator: unitvalidator.DataSizeMultipleOf("4GiB").In(units.Gibibytes), value: types.NumberValue(big.NewFloat(8)), expected: false},
+		{name: "gibibytes fails", validator: unitvalidator.DataSizeMultipleOf("4GiB").In(units.Gibibytes), value: types.NumberValue(big.NewFloat(6)), expected: true},
+		{name: "fraction", validator: unitvalidator.DataSizeBetween("1GiB", "2GiB").In(units.Tebibytes), value: types.NumberValue(big.NewFloat(0.0009765625)), expected: false},
+		{name: "decimal at most", validator: unitvalidator.DataSizeAtMost("0.1GB").In(units.Gigabytes), value: types.NumberValue(big.NewFloat(0.1)), expected: false},
+		{name: "decimal multiple of", validator: unitvalidator.DataSizeMultipleOf("400MB").In(units.Gigabytes), value: types.NumberValue(big.NewFloat(1.2)), expected: false},
+		{name: "infinite", validator: unitvalidator.DataSizeAtLeast("1KiB"), value: types.NumberValue(big.NewFloat(math.Inf(1))), expected: true},
+		{name: "null", validator: unitvalidator.DataSizeAtLeast("1KiB"), value: types.NumberNull(), expected: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &validator.NumberResponse{}
+			tc.validator.ValidateNumber(context.Background(), validator.NumberRequest{Path: path.Root("size"), ConfigValue: tc.value}, resp)
+			if resp.Diagnostics.HasError() != tc.expected {
+				t.Errorf("expected error %t, got %v", tc.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDataSizeValidators_Description(t *testing.T) {
+	description := unitvalidator.DataSizeBetween("1GiB", "2GiB").Description(context.Background())
+	if description != "value must be between 1GiB and 2GiB" {
+		t.Errorf("unexpected description %q", description)
+	}
+}
+
+func TestDataSizeValidators_Panics(t *testing.T) {
+	for name, f := range map[string]func(){
+		"limit":   func() { unitvalidator.DataSizeAtLeast("1 min") },
+		"in unit": func() { unitvalidator.DataSizeAtLeast("1GiB").In(units.Seconds) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic")
+				}
+			}()
+			f()
+		})
+	}
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unitvalidator
+
+import (
+	"fmt"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+const duration = "duration"
+
+// DurationAtLeast returns a validator which ensures that a duration is at least min, e.g. "1m".
+// Number attributes are measured in seconds, unless another unit is set with In.
+// It panics if min is not a duration.
+func DurationAtLeast(min string) QuantityValidator {
+	limit := mustParse(units.ParseDuration, min)
+
+	return newQuantityValidator(duration, units.ParseDuration, units.Seconds, fmt.Sprintf("value must be at least %s", min), atLeast(limit))
+}
+
+// DurationAtMost returns a validator which ensures that a duration is at most max, e.g. "15m".
+// Number attributes are measured in seconds, unless another unit is set with In.
+// It panics if max is not a duration.
+func DurationAtMost(max string) QuantityValidator {
+	limit := mustParse(units.ParseDuration, max)
+
+	return newQuantityValidator(duration, units.ParseDuration, units.Seconds, fmt.Sprintf("value must be at most %s", max), atMost(limit))
+}
+
+// DurationBetween returns a validator which ensures that a duration is from min to max inclusive.
+// Number attributes are measured in seconds, unless another unit is set with In.
+// It panics if min or max is not a duration.
+func DurationBetween(min, max string) QuantityValidator {
+	lower, upper := mustParse(units.ParseDuration, min), mustParse(units.ParseDuration, max)
+
+	return newQuantityValidator(duration, units.ParseDuration, units.Seconds, fmt.Sprintf("value must be between %s and %s", min, max), between(lower, upper))
+}
+
+// DurationMultipleOf returns a validator which ensures that a duration is an integer multiple of step, e.g. "5m".
+// Number attributes are measured in seconds, unless another unit is set with In.
+// It panics if step is not a duration.
+func DurationMultipleOf(step string) QuantityValidator {
+	limit := mustParse(units.ParseDuration, step)
+
+	return newQuantityValidator(duration, units.ParseDuration, units.Seconds, fmt.Sprintf("value must be a multiple of %s", step), multipleOf(limit))
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unitvalidator_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+	"github.com/dstaroff/terraform-provider-units/pkg/unitvalidator"
+)
+
+func TestDurationValidators(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		validator unitvalidator.QuantityValidator
+		value     types.String
+		expected  bool
+	}{
+		{name: "at most", validator: unitvalidator.DurationAtMost("15m"), value: types.StringValue("900s"), expected: false},
+		{name: "at most equal", validator: unitvalidator.DurationAtMost("15m"), value: types.StringValue("0.25 h"), expected: false},
+		{name: "at most exceeded", validator: unitvalidator.DurationAtMost("15m"), value: types.StringValue("1h"), expected: true},
+		{name: "at least", validator: unitvalidator.DurationAtLeast("1d"), value: types.StringValue("1 week"), expected: false},
+		{name: "between", validator: unitvalidator.DurationBetween("1m", "1h"), value: types.StringValue("30m"), expected: false},
+		{name: "multiple of", validator: unitvalidator.DurationMultipleOf("5m"), value: types.StringValue("1h"), expected: false},
+		{name: "multiple of fails", validator: unitvalidator.DurationMultipleOf("5m"), value: types.StringValue("62m"), expected: true},
+		{name: "minutes", validator: unitvalidator.DurationAtMost("15m"), value: types.StringValue("15 m"), expected: false},
+		{name: "not a duration", validator: unitvalidator.DurationAtMost("15m"), value: types.StringValue("15 km"), expected: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			tc.validator.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("timeout"), ConfigValue: tc.value}, resp)
+			if resp.Diagnostics.HasError() != tc.expected {
+				t.Errorf("expected error %t, got %v", tc.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDurationValidators_Number(t *testing.T) {
+	resp := &validator.NumberResponse{}
+	unitvalidator.DurationAtMost("15m").In(units.Minutes).ValidateNumber(context.Background(), validator.NumberRequest{
+		Path:        path.Root("timeout"),
+		ConfigValue: types.NumberValue(big.NewFloat(16)),
+	}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error")
+	}
+
+	expected := "Attribute timeout value must be at most 15m, got: 16 min"
+	if detail := resp.Diagnostics[0].Detail(); detail != expected {
+		t.Errorf("expected %q, got %q", expected, detail)
+	}
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+// Package unitvalidator provides terraform-plugin-framework validators of quantities with human-readable limits,
+// e.g. DataSizeAtLeast("1GiB") or DurationAtMost("15m").
+//
+// Validators of quantities apply to string attributes holding quantities, e.g. "20GiB",
+// and to number attributes measured in the base unit of a category, e.g. bytes, unless another unit is set with In.
+package unitvalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+var (
+	_ validator.String = QuantityValidator{}
+	_ validator.Number = QuantityValidator{}
+)
+
+type parseFunc func(string) (units.Quantity, error)
+
+// QuantityValidator validates that a quantity satisfies a condition.
+type QuantityValidator struct {
+	quantity string
+	parse    parseFunc
+	unit     units.Unit
+
+	description string
+	valid       func(units.Quantity) bool
+}
+
+// In returns a copy of v, which validates number attributes measured in unit.
+// It panics if unit doesn't measure the quantity of v.
+func (v QuantityValidator) In(unit units.Unit) QuantityValidator {
+	if !units.Compatible(unit, v.unit) {
+		panic(fmt.Sprintf("%s validator: %s is not a unit of %s", v.quantity, unit.Name, v.quantity))
+	}
+	v.unit = unit
+
+	return v
+}
+
+func (v QuantityValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v QuantityValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v QuantityValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	q, err := v.parse(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			fmt.Sprintf("must be a %s: %s", v.quantity, err),
+			req.ConfigValue.ValueString(),
+		))
+
+		return
+	}
+
+	if !v.valid(q) {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+func (v QuantityValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// The number is taken as the shortest decimal representing it, e.g. 0.1 rather than its binary expansion.
+	number := req.ConfigValue.ValueBigFloat()
+	value, ok := units.Decimal(number)
+	if !ok {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			fmt.Sprintf("must be a finite %s", v.quantity),
+			number.Text('g', -1),
+		))
+
+		return
+	}
+	q := units.NewQuantity(value, v.unit)
+
+	if !v.valid(q) {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			q.String(),
+		))
+	}
+}
+
+// newQuantityValidator creates a validator of quantities parsed with parse, measured in unit when they are numbers.
+func newQuantityValidator(quantity string, parse parseFunc, unit units.Unit, description string, valid func(units.Quantity) bool) QuantityValidator {
+	return QuantityValidator{
+		quantity:    quantity,
+		parse:       parse,
+		unit:        unit,
+		description: description,
+		valid:       valid,
+	}
+}
+
+// mustParse parses a limit of a validator. It panics on error, since limits are constants of a provider schema.
+func mustParse(parse parseFunc, limit string) units.Quantity {
+	q, err := parse(limit)
+	if err != nil {
+		panic(err)
+	}
+
+	return q
+}
+
+// compare returns a condition which holds if the comparison of a quantity with limit satisfies ok.
+func compare(limit units.Quantity, ok func(cmp int) bool) func(units.Quantity) bool {
+	return func(q units.Quantity) bool {
+		cmp, err := q.Cmp(limit)
+
+		return err == nil && ok(cmp)
+	}
+}
+
+func atLeast(limit units.Quantity) func(units.Quantity) bool {
+	return compare(limit, func(cmp int) bool { return cmp >= 0 })
+}
+
+func atMost(limit units.Quantity) func(units.Quantity) bool {
+	return compare(limit, func(cmp int) bool { return cmp <= 0 })
+}
+
+func between(min, max units.Quantity) func(units.Quantity) bool {
+	return func(q units.Quantity) bool {
+		return atLeast(min)(q) && atMost(max)(q)
+	}
+}
+
+// multipleOf returns a condition which holds if a quantity is an integer multiple of step.
+func multipleOf(step units.Quantity) func(units.Quantity) bool {
+	return func(q units.Quantity) bool {
+		value, err := q.In(step.Unit)
+		if err != nil || step.Value.Sign() == 0 {
+			return false
+		}
+
+		return new(big.Rat).Quo(value.Value, step.Value).IsInt()
+	}
+}
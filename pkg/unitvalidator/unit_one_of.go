@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unitvalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/units"
+)
+
+var _ validator.String = unitOneOfValidator{}
+
+// unitOneOfValidator validates that a string attribute is one of units, spelled with any name, symbol or alias.
+type unitOneOfValidator struct {
+	names []string
+	units []units.Unit
+}
+
+func (v unitOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of units: %q", v.names)
+}
+
+func (v unitOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v unitOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	unit, err := units.Default.Parse(req.ConfigValue.ValueString())
+	if err == nil {
+		for _, allowed := range v.units {
+			if unit.Symbol == allowed.Symbol && unit.Name == allowed.Name {
+				return
+			}
+		}
+	}
+
+	description := v.Description(ctx)
+	if err != nil {
+		description = fmt.Sprintf("%s: %s", description, err)
+	}
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		description,
+		req.ConfigValue.ValueString(),
+	))
+}
+
+// UnitOneOf returns a validator which ensures that a string attribute is one of units,
+// e.g. UnitOneOf("GiB", "TiB") accepts "GiB", "gibibytes" and "TiB".
+// Units are parsed as unit expressions of the Default registry, so compound units, e.g. "MiB/s", are allowed.
+// It panics if a unit is unknown.
+func UnitOneOf(names ...string) validator.String {
+	v := unitOneOfValidator{names: names}
+	for _, name := range names {
+		unit, err := units.Default.Parse(name)
+		if err != nil {
+			panic(err)
+		}
+		v.units = append(v.units, unit)
+	}
+
+	return v
+}
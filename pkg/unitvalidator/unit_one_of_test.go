@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2026. Dmitry Starov
+ * SPDX-License-Identifier: MPL-2.0
+ */
+
+package unitvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/dstaroff/terraform-provider-units/pkg/unitvalidator"
+)
+
+func TestUnitOneOf(t *testing.T) {
+	v := unitvalidator.UnitOneOf("GiB", "TiB", "MiB/s")
+
+	for _, tc := range []struct {
+		value    types.String
+		expected bool
+	}{
+		{value: types.StringValue("GiB"), expected: false},
+		{value: types.StringValue("gibibytes"), expected: false},
+		{value: types.StringValue("tebibyte"), expected: false},
+		{value: types.StringValue("mebibytes / second"), expected: false},
+		{value: types.StringValue("GB"), expected: true},
+		{value: types.StringValue("MiB"), expected: true},
+		{value: types.StringValue("gibibites"), expected: true},
+		{value: types.StringNull(), expected: false},
+	} {
+		t.Run(tc.value.String(), func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("unit"), ConfigValue: tc.value}, resp)
+			if resp.Diagnostics.HasError() != tc.expected {
+				t.Errorf("expected error %t, got %v", tc.expected, resp.Diagnostics)
+			}
+		})
+	}
+}